@@ -0,0 +1,254 @@
+// Benchmarks for the go-utils package
+package goutils
+
+import (
+	// Standard lib
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+var (
+	// benchIntSlice is a slice of ints used as input to benchmarks
+	benchIntSlice = []int{123456, 234567, 345678, 456789, 567890, 678901, 789012, 890123}
+)
+
+// BenchmarkAppendBool benchmarks the `AppendBool` method
+func BenchmarkAppendBool(b *testing.B) {
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendBool(buf[:0], true)
+	}
+}
+
+// BenchmarkAppendFloat64 benchmarks the `AppendFloat64` method
+func BenchmarkAppendFloat64(b *testing.B) {
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendFloat64(buf[:0], 234.567)
+	}
+}
+
+// BenchmarkAppendInt benchmarks the `AppendInt` method
+func BenchmarkAppendInt(b *testing.B) {
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendInt(buf[:0], 123456)
+	}
+}
+
+// BenchmarkAppendInt64 benchmarks the `AppendInt64` method
+func BenchmarkAppendInt64(b *testing.B) {
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendInt64(buf[:0], 123456)
+	}
+}
+
+// BenchmarkAppendIntSlice benchmarks the `AppendIntSlice` method
+func BenchmarkAppendIntSlice(b *testing.B) {
+	buf := make([]byte, 0, 128)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendIntSlice(buf[:0], benchIntSlice, ",")
+	}
+}
+
+// BenchmarkBool2String benchmarks the `Bool2String` method
+func BenchmarkBool2String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Bool2String(true)
+	}
+}
+
+// BenchmarkFloat642String benchmarks the `Float642String` method
+func BenchmarkFloat642String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Float642String(234.567)
+	}
+}
+
+// BenchmarkIntSlice2StringSlice benchmarks the `IntSlice2StringSlice` method
+func BenchmarkIntSlice2StringSlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		IntSlice2StringSlice(benchIntSlice)
+	}
+}
+
+// BenchmarkInt2String benchmarks the `Int2String` method
+func BenchmarkInt2String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Int2String(123456)
+	}
+}
+
+// BenchmarkInt642String benchmarks the `Int642String` method
+func BenchmarkInt642String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Int642String(123456)
+	}
+}
+
+// BenchmarkInterface2String benchmarks the `Interface2String` method
+func BenchmarkInterface2String(b *testing.B) {
+	var v interface{} = int64(123456)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Interface2String(v)
+	}
+}
+
+// BenchmarkMapFromInterface benchmarks the `MapFromInterface` method
+func BenchmarkMapFromInterface(b *testing.B) {
+	var v interface{} = map[string]interface{}{"foo": 1234}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MapFromInterface(v)
+	}
+}
+
+// BenchmarkString2Bool benchmarks the `String2Bool` method
+func BenchmarkString2Bool(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		String2Bool("true")
+	}
+}
+
+// BenchmarkString2Float64 benchmarks the `String2Float64` method
+func BenchmarkString2Float64(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		String2Float64("234.567")
+	}
+}
+
+// BenchmarkString2Int benchmarks the `String2Int` method
+func BenchmarkString2Int(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		String2Int("123456")
+	}
+}
+
+// BenchmarkString2Int64 benchmarks the `String2Int64` method
+func BenchmarkString2Int64(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		String2Int64("123456")
+	}
+}
+
+// benchSortedStrings returns a sorted slice of strings used as input to search benchmarks
+func benchSortedStrings(n int) []string {
+	// Form return value
+	ret := make([]string, n)
+	for i := range ret {
+		ret[i] = fmt.Sprintf("value-%08d", i)
+	}
+
+	return ret
+}
+
+// BenchmarkSliceContains benchmarks the linear `SliceContains` method against a large sorted slice
+func BenchmarkSliceContains(b *testing.B) {
+	haystack := benchSortedStrings(10000)
+	needle := haystack[len(haystack)*3/4]
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SliceContains(needle, haystack)
+	}
+}
+
+// BenchmarkSortedContains benchmarks the binary search `SortedContains` method against a large sorted slice
+func BenchmarkSortedContains(b *testing.B) {
+	haystack := benchSortedStrings(10000)
+	needle := haystack[len(haystack)*3/4]
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SortedContains(needle, haystack)
+	}
+}
+
+// BenchmarkMergeSorted benchmarks the `MergeSorted` method
+func BenchmarkMergeSorted(b *testing.B) {
+	sorted := [][]string{benchSortedStrings(1000), benchSortedStrings(1000), benchSortedStrings(1000)}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MergeSorted(sorted...)
+	}
+}
+
+// benchConnServer returns a test server and a counter of the connections opened to it
+func benchConnServer() (*httptest.Server, *atomic.Int64) {
+	conns := &atomic.Int64{}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+
+	return server, conns
+}
+
+// BenchmarkMakeRequestNewClient benchmarks making requests with a new client (and transport) per request,
+// reporting the number of connections opened per request
+func BenchmarkMakeRequestNewClient(b *testing.B) {
+	server, conns := benchConnServer()
+	defer server.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		client := &http.Client{Transport: &http.Transport{}}
+		GetStatusCodeForRequest(&RequestConfig{Client: client, Method: "GET", URL: server.URL})
+		client.CloseIdleConnections()
+	}
+
+	b.ReportMetric(float64(conns.Load())/float64(b.N), "conns/op")
+}
+
+// BenchmarkMakeRequestSharedClient benchmarks making requests with a shared client from `DefaultClientFactory`,
+// reporting the number of connections opened per request
+func BenchmarkMakeRequestSharedClient(b *testing.B) {
+	server, conns := benchConnServer()
+	defer server.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		GetStatusCodeForRequest(&RequestConfig{Method: "GET", Timeout: 5, URL: server.URL})
+	}
+
+	b.ReportMetric(float64(conns.Load())/float64(b.N), "conns/op")
+}
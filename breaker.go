@@ -0,0 +1,298 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// BreakerClosed is the state of a circuit that allows requests, tracking their failures
+	BreakerClosed BreakerState = iota
+	// BreakerOpen is the state of a circuit that rejects requests until it's cooldown has passed
+	BreakerOpen
+	// BreakerHalfOpen is the state of a circuit that allows a limited number of probe requests,
+	// closing if they all succeed and re-opening if any fail
+	BreakerHalfOpen
+)
+
+const (
+	// breakerBuckets is the number of buckets a breaker's rolling window is split into
+	breakerBuckets = 10
+)
+
+type (
+	// BreakerState represents the state of a circuit breaker's circuit
+	BreakerState int
+
+	// BreakerConfig contains a set of configuration settings
+	// to be used with circuit breakers
+	BreakerConfig struct {
+		Cooldown       time.Duration                    // How long an open circuit rejects requests before allowing probes
+		FailureRate    float64                          // The rate of failures (from 0 to 1) within the window that opens the circuit
+		HalfOpenProbes int                              // The number of probe requests allowed (and needed to succeed) while half-open
+		IsFailure      func(*http.Response, error) bool // Determines whether a request failed, if set. Defaults to errors and 5xx responses
+		MinRequests    int                              // The minimum number of requests within the window before the failure rate is checked
+		OnStateChange  func(BreakerEvent)               // Called whenever a circuit changes state, if set
+		Window         time.Duration                    // The length of the rolling window failures are tracked over
+	}
+
+	// BreakerEvent represents a change in the state of a circuit
+	BreakerEvent struct {
+		From BreakerState // The previous state of the circuit
+		Host string       // The host of the circuit
+		Time time.Time    // When the state changed
+		To   BreakerState // The new state of the circuit
+	}
+
+	// CircuitBreaker tracks the failures of requests made to each host, rejecting requests
+	// to hosts that are failing so callers don't wait on them
+	// NOTE: Safe for concurrent use, and meant to be shared by requests through `RequestConfig.Breaker`
+	CircuitBreaker struct {
+		config   *BreakerConfig      // The settings of the breaker
+		circuits map[string]*circuit // The circuit of each host
+		mu       sync.Mutex          // Guards circuits
+	}
+
+	// circuit tracks the state of requests made to a single host
+	circuit struct {
+		buckets   [breakerBuckets]breakerBucket // The buckets of the rolling window
+		inFlight  int                           // The number of probes in flight while half-open
+		openedAt  time.Time                     // When the circuit was last opened
+		state     BreakerState                  // The state of the circuit
+		successes int                           // The number of successful probes while half-open
+	}
+
+	// breakerBucket counts the outcomes of requests made during a slice of a rolling window
+	breakerBucket struct {
+		failures  int   // The number of failed requests
+		index     int64 // The index of the slice of time the bucket counts
+		successes int   // The number of successful requests
+	}
+)
+
+var (
+	// ErrCircuitOpen is returned (wrapped) for requests rejected by a circuit breaker
+	ErrCircuitOpen = errors.New("Circuit breaker is open")
+)
+
+// NewBreakerConfig returns a BreakerConfig struct with
+// default settings set for each of it's properties
+func NewBreakerConfig() *BreakerConfig {
+	return &BreakerConfig{
+		Cooldown:       30 * time.Second,
+		FailureRate:    0.5,
+		HalfOpenProbes: 1,
+		IsFailure:      nil,
+		MinRequests:    10,
+		OnStateChange:  nil,
+		Window:         time.Minute,
+	}
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker using the settings of a config
+// NOTE: A nil config uses the defaults from `NewBreakerConfig`
+func NewCircuitBreaker(c *BreakerConfig) *CircuitBreaker {
+	if c == nil {
+		c = NewBreakerConfig()
+	}
+
+	return &CircuitBreaker{
+		config:   c,
+		circuits: make(map[string]*circuit),
+	}
+}
+
+// String returns the name of a breaker state
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Allow returns nil if a request to a host may be made, or an error wrapping `ErrCircuitOpen` otherwise
+// NOTE: Each allowed request must be followed by a call to `Record` with it's outcome
+func (b *CircuitBreaker) Allow(host string) error {
+	b.mu.Lock()
+
+	now := time.Now()
+	c := b.circuit(host)
+	events := b.refresh(host, c, now)
+
+	var err error
+	switch {
+	case c.state == BreakerOpen:
+		err = fmt.Errorf("%w for host '%s'", ErrCircuitOpen, host)
+	case c.state == BreakerHalfOpen && c.inFlight >= max(b.config.HalfOpenProbes, 1):
+		err = fmt.Errorf("%w for host '%s' while probing", ErrCircuitOpen, host)
+	case c.state == BreakerHalfOpen:
+		c.inFlight++
+	}
+
+	b.mu.Unlock()
+	b.emit(events)
+
+	return err
+}
+
+// Record records the outcome of a request to a host, changing the state of it's circuit if needed
+func (b *CircuitBreaker) Record(host string, success bool) {
+	b.mu.Lock()
+
+	now := time.Now()
+	c := b.circuit(host)
+	events := b.refresh(host, c, now)
+
+	switch c.state {
+	case BreakerClosed:
+		// Count the outcome, opening the circuit if too many requests failed
+		bucket := c.bucket(b.bucketIndex(now))
+		if success {
+			bucket.successes++
+		} else {
+			bucket.failures++
+		}
+
+		successes, failures := c.counts(b.bucketIndex(now))
+		total := successes + failures
+
+		if total >= b.config.MinRequests && float64(failures)/float64(total) >= b.config.FailureRate {
+			events = append(events, b.transition(host, c, BreakerOpen, now))
+		}
+	case BreakerHalfOpen:
+		// Close the circuit once enough probes succeed, or re-open it if any fail
+		c.inFlight = max(c.inFlight-1, 0)
+
+		if !success {
+			events = append(events, b.transition(host, c, BreakerOpen, now))
+		} else if c.successes++; c.successes >= max(b.config.HalfOpenProbes, 1) {
+			events = append(events, b.transition(host, c, BreakerClosed, now))
+		}
+	}
+
+	b.mu.Unlock()
+	b.emit(events)
+}
+
+// State returns the current state of a host's circuit
+func (b *CircuitBreaker) State(host string) BreakerState {
+	b.mu.Lock()
+
+	c := b.circuit(host)
+	events := b.refresh(host, c, time.Now())
+	state := c.state
+
+	b.mu.Unlock()
+	b.emit(events)
+
+	return state
+}
+
+// bucketIndex returns the index of the slice of time of the rolling window a time falls in
+func (b *CircuitBreaker) bucketIndex(t time.Time) int64 {
+	width := max(b.config.Window/breakerBuckets, time.Millisecond)
+
+	return t.UnixNano() / int64(width)
+}
+
+// circuit returns the circuit of a host, creating it if needed
+// NOTE: Must be called with the lock held
+func (b *CircuitBreaker) circuit(host string) *circuit {
+	c, ok := b.circuits[host]
+	if !ok {
+		c = &circuit{state: BreakerClosed}
+		b.circuits[host] = c
+	}
+
+	return c
+}
+
+// emit sends state change events to the config's `OnStateChange` function, if set
+// NOTE: Must be called without the lock held, so the function can use the breaker
+func (b *CircuitBreaker) emit(events []BreakerEvent) {
+	if b.config.OnStateChange == nil {
+		return
+	}
+
+	for _, e := range events {
+		b.config.OnStateChange(e)
+	}
+}
+
+// isFailure returns true if a request's outcome counts as a failure
+func (b *CircuitBreaker) isFailure(res *http.Response, err error) bool {
+	if b.config.IsFailure != nil {
+		return b.config.IsFailure(res, err)
+	}
+
+	return err != nil || res.StatusCode >= 500
+}
+
+// release frees a probe allowed by `Allow` without recording an outcome,
+// for requests that were cancelled by the caller
+func (b *CircuitBreaker) release(host string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c := b.circuit(host); c.state == BreakerHalfOpen {
+		c.inFlight = max(c.inFlight-1, 0)
+	}
+}
+
+// refresh moves an open circuit to half-open once it's cooldown has passed
+// NOTE: Must be called with the lock held
+func (b *CircuitBreaker) refresh(host string, c *circuit, now time.Time) []BreakerEvent {
+	if c.state != BreakerOpen || now.Sub(c.openedAt) < b.config.Cooldown {
+		return nil
+	}
+
+	return []BreakerEvent{b.transition(host, c, BreakerHalfOpen, now)}
+}
+
+// transition changes the state of a circuit, resetting it's counts, and returns the event describing it
+// NOTE: Must be called with the lock held
+func (b *CircuitBreaker) transition(host string, c *circuit, to BreakerState, now time.Time) BreakerEvent {
+	e := BreakerEvent{From: c.state, Host: host, Time: now, To: to}
+
+	c.buckets = [breakerBuckets]breakerBucket{}
+	c.inFlight, c.successes, c.state = 0, 0, to
+
+	if to == BreakerOpen {
+		c.openedAt = now
+	}
+
+	return e
+}
+
+// bucket returns the bucket for a slice of time, resetting it if it last counted an older slice
+func (c *circuit) bucket(index int64) *breakerBucket {
+	bucket := &c.buckets[index%breakerBuckets]
+	if bucket.index != index {
+		*bucket = breakerBucket{index: index}
+	}
+
+	return bucket
+}
+
+// counts returns the number of successful and failed requests within the rolling window ending at a slice of time
+func (c *circuit) counts(index int64) (successes, failures int) {
+	for _, bucket := range c.buckets {
+		if bucket.index > index-breakerBuckets && bucket.index <= index {
+			successes += bucket.successes
+			failures += bucket.failures
+		}
+	}
+
+	return successes, failures
+}
@@ -0,0 +1,281 @@
+// Tests the breaker.go file
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("breaker.go", func() {
+	var (
+		// Breaker to test against
+		breaker *CircuitBreaker
+		// Config of the breaker
+		config *BreakerConfig
+		// State change events emitted by the breaker
+		events []BreakerEvent
+		// Guards events
+		mu sync.Mutex
+	)
+
+	BeforeEach(func() {
+		// Reset test data
+		events = nil
+
+		config = &BreakerConfig{
+			Cooldown:       20 * time.Millisecond,
+			FailureRate:    0.5,
+			HalfOpenProbes: 2,
+			MinRequests:    4,
+			OnStateChange: func(e BreakerEvent) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				events = append(events, e)
+			},
+			Window: time.Minute,
+		}
+
+		breaker = NewCircuitBreaker(config)
+	})
+
+	Describe("`NewBreakerConfig` method", func() {
+		It("Returns a valid breaker config struct", func() {
+			// Call method
+			c := NewBreakerConfig()
+
+			// Verify breaker config was properly created and returned
+			Expect(c.Cooldown).To(Equal(30 * time.Second))
+			Expect(c.FailureRate).To(Equal(0.5))
+			Expect(c.HalfOpenProbes).To(Equal(1))
+			Expect(c.IsFailure).To(BeNil())
+			Expect(c.MinRequests).To(Equal(10))
+			Expect(c.OnStateChange).To(BeNil())
+			Expect(c.Window).To(Equal(time.Minute))
+		})
+	})
+
+	Describe("`NewCircuitBreaker` method", func() {
+		It("Uses the default config when none is passed in", func() {
+			Expect(NewCircuitBreaker(nil).config).To(Equal(NewBreakerConfig()))
+		})
+	})
+
+	Describe("`BreakerState` type", func() {
+		It("Returns the name of each state", func() {
+			Expect(BreakerClosed.String()).To(Equal("closed"))
+			Expect(BreakerOpen.String()).To(Equal("open"))
+			Expect(BreakerHalfOpen.String()).To(Equal("half-open"))
+			Expect(BreakerState(-1).String()).To(Equal("unknown"))
+		})
+	})
+
+	Describe("`CircuitBreaker` type", func() {
+		It("Stays closed until the minimum number of requests is reached", func() {
+			// Record failures
+			for i := 0; i < 3; i++ {
+				Expect(breaker.Allow("foo")).To(Succeed())
+				breaker.Record("foo", false)
+			}
+
+			// Verify circuit is still closed
+			Expect(breaker.State("foo")).To(Equal(BreakerClosed))
+			Expect(events).To(BeEmpty())
+		})
+
+		It("Stays closed while the failure rate is below the threshold", func() {
+			// Record mostly successes
+			for _, success := range []bool{true, true, false, true, true, false} {
+				breaker.Record("foo", success)
+			}
+
+			// Verify circuit is still closed
+			Expect(breaker.State("foo")).To(Equal(BreakerClosed))
+		})
+
+		It("Opens, probes and closes a circuit", func() {
+			// Record failures to open the circuit
+			for _, success := range []bool{true, false, true, false} {
+				breaker.Record("foo", success)
+			}
+
+			// Verify circuit is open and rejects requests
+			Expect(breaker.State("foo")).To(Equal(BreakerOpen))
+			Expect(errors.Is(breaker.Allow("foo"), ErrCircuitOpen)).To(BeTrue())
+
+			// Verify other hosts are unaffected
+			Expect(breaker.Allow("bar")).To(Succeed())
+
+			// Wait for cooldown, then verify a limited number of probes are allowed
+			time.Sleep(config.Cooldown)
+			Expect(breaker.Allow("foo")).To(Succeed())
+			Expect(breaker.Allow("foo")).To(Succeed())
+			Expect(errors.Is(breaker.Allow("foo"), ErrCircuitOpen)).To(BeTrue())
+			Expect(breaker.State("foo")).To(Equal(BreakerHalfOpen))
+
+			// Verify circuit closes once probes succeed
+			breaker.Record("foo", true)
+			Expect(breaker.State("foo")).To(Equal(BreakerHalfOpen))
+			breaker.Record("foo", true)
+			Expect(breaker.State("foo")).To(Equal(BreakerClosed))
+
+			// Verify events were emitted
+			Expect(events).To(HaveLen(3))
+			Expect(events[0].Host).To(Equal("foo"))
+			Expect([]BreakerState{events[0].From, events[0].To}).To(Equal([]BreakerState{BreakerClosed, BreakerOpen}))
+			Expect([]BreakerState{events[1].From, events[1].To}).To(Equal([]BreakerState{BreakerOpen, BreakerHalfOpen}))
+			Expect([]BreakerState{events[2].From, events[2].To}).To(Equal([]BreakerState{BreakerHalfOpen, BreakerClosed}))
+		})
+
+		It("Re-opens a circuit when a probe fails", func() {
+			// Open the circuit, then wait for cooldown
+			for i := 0; i < 4; i++ {
+				breaker.Record("foo", false)
+			}
+
+			time.Sleep(config.Cooldown)
+
+			// Fail a probe
+			Expect(breaker.Allow("foo")).To(Succeed())
+			breaker.Record("foo", false)
+
+			// Verify circuit is open again
+			Expect(breaker.State("foo")).To(Equal(BreakerOpen))
+			Expect(events[len(events)-1].To).To(Equal(BreakerOpen))
+		})
+
+		It("Forgets failures outside of the rolling window", func() {
+			// Shorten window
+			config.Window = 50 * time.Millisecond
+
+			// Record failures, then wait for them to leave the window
+			for i := 0; i < 3; i++ {
+				breaker.Record("foo", false)
+			}
+
+			time.Sleep(2 * config.Window)
+			breaker.Record("foo", false)
+
+			// Verify circuit is still closed
+			Expect(breaker.State("foo")).To(Equal(BreakerClosed))
+		})
+	})
+
+	Describe("Making requests with a circuit breaker", func() {
+		var (
+			// Number of requests sent by the client
+			attempts int
+			// Config to test against
+			c *RequestConfig
+		)
+
+		BeforeEach(func() {
+			// Set config with a client that always fails
+			attempts = 0
+			c = &RequestConfig{
+				Breaker: breaker,
+				Client: &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						if err := req.Context().Err(); err != nil {
+							return nil, err
+						}
+
+						attempts++
+						return &http.Response{Body: newTrackingBody(0), StatusCode: 500}, nil
+					}),
+				},
+				Method: "GET",
+				URL:    "http://example.com/path",
+			}
+		})
+
+		It("Rejects requests once the host's circuit is open", func() {
+			// Make failing requests
+			for i := 0; i < 4; i++ {
+				code, err := GetStatusCodeForRequest(c)
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(code).To(Equal(500))
+			}
+
+			// Verify next request is rejected without being sent
+			_, err := GetStatusCodeForRequest(c)
+			Expect(errors.Is(err, ErrCircuitOpen)).To(BeTrue())
+			Expect(attempts).To(Equal(4))
+			Expect(breaker.State("example.com")).To(Equal(BreakerOpen))
+		})
+
+		It("Doesn't retry rejected requests", func() {
+			// Open the circuit
+			for i := 0; i < 4; i++ {
+				breaker.Record("example.com", false)
+			}
+
+			// Call method
+			c.Retry = &RetryPolicy{BaseDelay: time.Hour, MaxAttempts: 3}
+			_, err := GetStatusCodeForRequest(c)
+
+			// Verify return value
+			Expect(errors.Is(err, ErrCircuitOpen)).To(BeTrue())
+			Expect(attempts).To(BeZero())
+		})
+
+		It("Uses a custom failure function", func() {
+			// Count 500s as successes
+			config.IsFailure = func(res *http.Response, err error) bool {
+				return err != nil
+			}
+
+			// Make requests
+			for i := 0; i < 5; i++ {
+				GetStatusCodeForRequest(c)
+			}
+
+			// Verify circuit is still closed
+			Expect(breaker.State("example.com")).To(Equal(BreakerClosed))
+		})
+
+		It("Counts requests that pass the caller's deadline as failures", func() {
+			// Make requests that pass their deadline
+			ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+			defer cancel()
+
+			for i := 0; i < 4; i++ {
+				_, err := GetStatusCodeForRequestContext(ctx, c)
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			}
+
+			// Verify circuit was opened
+			Expect(breaker.State("example.com")).To(Equal(BreakerOpen))
+		})
+
+		It("Ignores requests cancelled by the caller", func() {
+			// Open the circuit, then wait for cooldown
+			for i := 0; i < 4; i++ {
+				breaker.Record("example.com", false)
+			}
+
+			time.Sleep(config.Cooldown)
+
+			// Make cancelled requests
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			for i := 0; i < 3; i++ {
+				_, err := GetStatusCodeForRequestContext(ctx, c)
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+			}
+
+			// Verify probes were released
+			Expect(breaker.State("example.com")).To(Equal(BreakerHalfOpen))
+			Expect(breaker.Allow("example.com")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,240 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type (
+	// ClientFactory creates HTTP clients that share pooled, tuned transports,
+	// so connections are reused across requests
+	// NOTE: Safe for concurrent use. Clients are cached by their options, and clients whose options only
+	// differ by `Timeout` share a transport. Both are kept for the life of the factory, except when the
+	// files of their TLS settings change, in which case they're replaced and the old transport's idle connections are closed
+	ClientFactory struct {
+		clients    map[clientKey]*http.Client    // The cached clients, by their options
+		config     *TransportConfig              // The settings of created transports
+		fileTimes  map[clientKey]tlsFileTimes    // The modification times of the TLS files each transport was built from
+		mu         sync.Mutex                    // Guards clients, fileTimes and transports
+		transports map[clientKey]*http.Transport // The pooled transports, by their options without `Timeout`
+	}
+
+	// ClientOptions contains the settings of a client created by a ClientFactory
+	// NOTE: Zero values keep the settings of `http.DefaultTransport`
+	ClientOptions struct {
+		DialTimeout           time.Duration // The maximum time spent resolving a host and opening a connection
+		ResponseHeaderTimeout time.Duration // The maximum time spent waiting for a response's headers, once a request is sent
+		TLS                   *TLSConfig    // TLS settings used to make HTTPS requests, if any. Compared by value when pooling
+		TLSHandshakeTimeout   time.Duration // The maximum time spent on a TLS handshake
+		Timeout               time.Duration // The overall timeout of requests made by the client, or 0 for none
+	}
+
+	// TransportConfig contains a set of configuration settings
+	// to be used with the transports created by a ClientFactory
+	TransportConfig struct {
+		IdleConnTimeout     time.Duration // How long an idle connection is kept open
+		MaxConnsPerHost     int           // The maximum number of connections per host, or 0 for no limit
+		MaxIdleConns        int           // The maximum number of idle connections across all hosts
+		MaxIdleConnsPerHost int           // The maximum number of idle connections per host
+	}
+
+	// clientKey is a comparable representation of a set of client options, used to cache clients and transports
+	clientKey struct {
+		options ClientOptions // The options, without their TLS settings
+		tls     tlsKey        // The TLS settings of the options
+	}
+)
+
+var (
+	// DefaultClientFactory is the factory used to create clients for requests that don't set their own `Client`
+	// NOTE: Public variable to allow package authors the ability
+	// to tune transports before making requests
+	DefaultClientFactory = NewClientFactory(nil)
+)
+
+// NewClientFactory returns a new ClientFactory using the settings of a config
+// NOTE: A nil config uses the defaults from `NewTransportConfig`
+func NewClientFactory(c *TransportConfig) *ClientFactory {
+	if c == nil {
+		c = NewTransportConfig()
+	}
+
+	return &ClientFactory{
+		clients:    make(map[clientKey]*http.Client),
+		config:     c,
+		fileTimes:  make(map[clientKey]tlsFileTimes),
+		transports: make(map[clientKey]*http.Transport),
+	}
+}
+
+// NewTransportConfig returns a TransportConfig struct with
+// default settings set for each of it's properties
+// NOTE: Keeps more idle connections per host than `http.DefaultTransport`,
+// which only keeps 2, so bursts of requests to a single host reuse connections
+func NewTransportConfig() *TransportConfig {
+	return &TransportConfig{
+		IdleConnTimeout:     90 * time.Second,
+		MaxConnsPerHost:     0,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 32,
+	}
+}
+
+// Client returns the client for a set of options, creating it (and it's transport) if needed
+// NOTE: Returns an error if the options' TLS settings can't be built
+func (f *ClientFactory) Client(o ClientOptions) (*http.Client, error) {
+	// Check the TLS files for changes before locking, so requests aren't serialized on file system calls
+	times := o.TLS.fileTimes()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.evictRotated(o, times)
+
+	key := o.key()
+	if client, ok := f.clients[key]; ok {
+		return client, nil
+	}
+
+	t, err := f.transport(o, times)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		Timeout:   o.Timeout,
+		Transport: t,
+	}
+	f.clients[key] = client
+
+	return client, nil
+}
+
+// CloseIdleConnections closes the idle connections of every transport created by the factory
+func (f *ClientFactory) CloseIdleConnections() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range f.transports {
+		t.CloseIdleConnections()
+	}
+}
+
+// Reset closes the idle connections of every transport created by the factory,
+// and drops every cached client and transport so new ones are created for later requests
+// NOTE: Requests in flight aren't affected
+func (f *ClientFactory) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range f.transports {
+		t.CloseIdleConnections()
+	}
+
+	f.clients = make(map[clientKey]*http.Client)
+	f.fileTimes = make(map[clientKey]tlsFileTimes)
+	f.transports = make(map[clientKey]*http.Transport)
+}
+
+// evictRotated drops the pooled transport for a set of options (and every client using it)
+// if it was built from TLS files that have since changed, closing it's idle connections
+// NOTE: Must be called with the lock held
+func (f *ClientFactory) evictRotated(o ClientOptions, times tlsFileTimes) {
+	o.Timeout = 0
+
+	key := o.key()
+	t, ok := f.transports[key]
+	if !ok || f.fileTimes[key] == times {
+		return
+	}
+
+	t.CloseIdleConnections()
+
+	for k, client := range f.clients {
+		if client.Transport == t {
+			delete(f.clients, k)
+		}
+	}
+
+	delete(f.fileTimes, key)
+	delete(f.transports, key)
+}
+
+// transport returns the pooled transport for a set of options, creating it if needed
+// NOTE: Must be called with the lock held. The modification times of the TLS files are recorded
+// with new transports, so they can be replaced once the files change
+func (f *ClientFactory) transport(o ClientOptions, times tlsFileTimes) (*http.Transport, error) {
+	// Share transports between clients with different overall timeouts
+	o.Timeout = 0
+
+	key := o.key()
+	if t, ok := f.transports[key]; ok {
+		return t, nil
+	}
+
+	// Start from the default transport's settings (proxies, dialer, HTTP/2)
+	t := defaultTransport()
+	t.IdleConnTimeout = f.config.IdleConnTimeout
+	t.MaxConnsPerHost = f.config.MaxConnsPerHost
+	t.MaxIdleConns = f.config.MaxIdleConns
+	t.MaxIdleConnsPerHost = f.config.MaxIdleConnsPerHost
+
+	// Bound the phases of requests
+	if o.DialTimeout > 0 {
+		t.DialContext = (&net.Dialer{Timeout: o.DialTimeout, KeepAlive: 30 * time.Second}).DialContext
+	}
+
+	if o.ResponseHeaderTimeout > 0 {
+		t.ResponseHeaderTimeout = o.ResponseHeaderTimeout
+	}
+
+	if o.TLSHandshakeTimeout > 0 {
+		t.TLSHandshakeTimeout = o.TLSHandshakeTimeout
+	}
+
+	// Set TLS settings
+	if o.TLS != nil {
+		cfg, err := o.TLS.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		t.TLSClientConfig = cfg
+	}
+
+	f.fileTimes[key] = times
+	f.transports[key] = t
+
+	return t, nil
+}
+
+// defaultTransport returns a copy of `http.DefaultTransport`
+// NOTE: Falls back to a transport with the same default settings when `http.DefaultTransport`
+// has been replaced by one that isn't an `*http.Transport` (ex: by tracing or metrics libraries)
+func defaultTransport() *http.Transport {
+	if t, ok := http.DefaultTransport.(*http.Transport); ok {
+		return t.Clone()
+	}
+
+	return &http.Transport{
+		DialContext:           (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConns:          100,
+		Proxy:                 http.ProxyFromEnvironment,
+		TLSHandshakeTimeout:   10 * time.Second,
+	}
+}
+
+// key returns a comparable representation of the options
+func (o ClientOptions) key() clientKey {
+	key := clientKey{options: o, tls: o.TLS.key()}
+	key.options.TLS = nil
+
+	return key
+}
@@ -0,0 +1,206 @@
+// Tests the client.go file
+package goutils
+
+import (
+	// Standard lib
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("client.go", func() {
+	Describe("`NewTransportConfig` method", func() {
+		It("Returns a valid transport config struct", func() {
+			// Call method
+			c := NewTransportConfig()
+
+			// Verify transport config was properly created and returned
+			Expect(c.IdleConnTimeout).To(Equal(90 * time.Second))
+			Expect(c.MaxConnsPerHost).To(Equal(0))
+			Expect(c.MaxIdleConns).To(Equal(100))
+			Expect(c.MaxIdleConnsPerHost).To(Equal(32))
+		})
+	})
+
+	Describe("`NewClientFactory` method", func() {
+		It("Uses the default config when none is passed in", func() {
+			Expect(NewClientFactory(nil).config).To(Equal(NewTransportConfig()))
+		})
+	})
+
+	Describe("`ClientFactory` type", func() {
+		var (
+			// Factory to test against
+			factory *ClientFactory
+		)
+
+		BeforeEach(func() {
+			// Set factory
+			factory = NewClientFactory(&TransportConfig{IdleConnTimeout: time.Minute, MaxIdleConns: 10, MaxIdleConnsPerHost: 5})
+		})
+
+		It("Caches clients by their options", func() {
+			// Call method
+			a, _ := factory.Client(ClientOptions{Timeout: time.Second})
+			b, _ := factory.Client(ClientOptions{Timeout: time.Second})
+			c, _ := factory.Client(ClientOptions{Timeout: time.Minute})
+
+			// Verify return values
+			Expect(a).To(BeIdenticalTo(b))
+			Expect(a).To(Not(BeIdenticalTo(c)))
+			Expect(a.Timeout).To(Equal(time.Second))
+			Expect(c.Timeout).To(Equal(time.Minute))
+
+			// Verify clients share a tuned transport
+			Expect(a.Transport).To(BeIdenticalTo(c.Transport))
+
+			t := a.Transport.(*http.Transport)
+			Expect(t.IdleConnTimeout).To(Equal(time.Minute))
+			Expect(t.MaxIdleConns).To(Equal(10))
+			Expect(t.MaxIdleConnsPerHost).To(Equal(5))
+			Expect(t.Proxy).To(Not(BeNil()))
+
+			// Verify idle connections can be closed
+			factory.CloseIdleConnections()
+
+			// Verify clients are created again once reset
+			factory.Reset()
+			d, _ := factory.Client(ClientOptions{Timeout: time.Second})
+			Expect(d).To(Not(BeIdenticalTo(a)))
+			Expect(d.Transport).To(Not(BeIdenticalTo(a.Transport)))
+		})
+
+		It("Pools transports by the value of their TLS settings", func() {
+			// Call method
+			a, err := factory.Client(ClientOptions{TLS: &TLSConfig{ServerName: "foo"}})
+			Expect(err).To(Not(HaveOccurred()))
+			b, _ := factory.Client(ClientOptions{TLS: &TLSConfig{ServerName: "foo"}})
+			c, _ := factory.Client(ClientOptions{TLS: &TLSConfig{ServerName: "bar"}})
+
+			// Verify return values
+			Expect(a).To(BeIdenticalTo(b))
+			Expect(a.Transport).To(Not(BeIdenticalTo(c.Transport)))
+			Expect(a.Transport.(*http.Transport).TLSClientConfig.ServerName).To(Equal("foo"))
+		})
+
+		It("Replaces transports whose TLS files have changed", func() {
+			// Write the certificate of a test server as a CA bundle
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			defer server.Close()
+
+			dir, err := os.MkdirTemp("", "goutils-client")
+			Expect(err).To(Not(HaveOccurred()))
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "ca.pem")
+			Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)).To(Succeed())
+			Expect(os.Chtimes(path, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour))).To(Succeed())
+
+			// Create clients, then rotate the file
+			a, err := factory.Client(ClientOptions{TLS: &TLSConfig{CAFile: path}})
+			Expect(err).To(Not(HaveOccurred()))
+			b, _ := factory.Client(ClientOptions{TLS: &TLSConfig{CAFile: path}, Timeout: time.Second})
+
+			Expect(os.Chtimes(path, time.Now(), time.Now())).To(Succeed())
+
+			// Call method
+			c, err := factory.Client(ClientOptions{TLS: &TLSConfig{CAFile: path}})
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(c).To(Not(BeIdenticalTo(a)))
+			Expect(c.Transport).To(Not(BeIdenticalTo(a.Transport)))
+			Expect(factory.clients).To(HaveLen(1))
+			Expect(factory.transports).To(HaveLen(1))
+
+			// Verify clients with other timeouts share the new transport
+			d, _ := factory.Client(ClientOptions{TLS: &TLSConfig{CAFile: path}, Timeout: time.Second})
+			Expect(d).To(Not(BeIdenticalTo(b)))
+			Expect(d.Transport).To(BeIdenticalTo(c.Transport))
+
+			// Verify the new client can make requests
+			res, err := c.Get(server.URL)
+			Expect(err).To(Not(HaveOccurred()))
+			res.Body.Close()
+		})
+
+		It("Returns an error when the TLS settings can't be built", func() {
+			// Call method
+			_, err := factory.Client(ClientOptions{TLS: &TLSConfig{CAPEM: []byte("invalid")}})
+
+			// Verify return value
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Creates transports when the default transport has been replaced", func() {
+			// Replace the default transport with a wrapper
+			original := http.DefaultTransport
+			defer func() { http.DefaultTransport = original }()
+
+			http.DefaultTransport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				return original.RoundTrip(req)
+			})
+
+			// Create test server to mock responses
+			server := getMockServer("default")
+			defer server.Close()
+
+			// Call method
+			client, err := factory.Client(ClientOptions{})
+			Expect(err).To(Not(HaveOccurred()))
+
+			res, err := client.Get(server.URL)
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			defer res.Body.Close()
+
+			t := client.Transport.(*http.Transport)
+			Expect(res.StatusCode).To(Equal(200))
+			Expect(t.Proxy).To(Not(BeNil()))
+			Expect(t.ForceAttemptHTTP2).To(BeTrue())
+			Expect(t.MaxIdleConnsPerHost).To(Equal(5))
+		})
+	})
+
+	Describe("Making requests without a client", func() {
+		It("Uses a shared client, reusing connections, without modifying the config", func() {
+			// Create test server counting connections
+			conns := &atomic.Int64{}
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(200)
+			}))
+			server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					conns.Add(1)
+				}
+			}
+			server.Start()
+			defer server.Close()
+
+			// Make requests
+			c := &RequestConfig{Method: "GET", Timeout: 5, URL: server.URL}
+			for i := 0; i < 5; i++ {
+				code, err := GetStatusCodeForRequest(c)
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(code).To(Equal(200))
+			}
+
+			// Verify connection was reused and config wasn't modified
+			Expect(conns.Load()).To(Equal(int64(1)))
+			Expect(c.Client).To(BeNil())
+			client, _ := c.client()
+			shared, _ := DefaultClientFactory.Client(ClientOptions{Timeout: 5 * time.Second})
+			Expect(client).To(BeIdenticalTo(shared))
+		})
+	})
+})
@@ -9,6 +9,46 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// AppendBool appends the string form of a bool to a caller-supplied buffer
+// and returns the extended buffer
+func AppendBool(dst []byte, v bool) []byte {
+	return strconv.AppendBool(dst, v)
+}
+
+// AppendFloat64 appends the string form of a float64 to a caller-supplied buffer
+// and returns the extended buffer
+func AppendFloat64(dst []byte, v float64) []byte {
+	return strconv.AppendFloat(dst, v, 'f', -1, 64)
+}
+
+// AppendInt appends the string form of an int to a caller-supplied buffer
+// and returns the extended buffer
+func AppendInt(dst []byte, v int) []byte {
+	return strconv.AppendInt(dst, int64(v), 10)
+}
+
+// AppendInt64 appends the string form of an int64 to a caller-supplied buffer
+// and returns the extended buffer
+func AppendInt64(dst []byte, v int64) []byte {
+	return strconv.AppendInt(dst, v, 10)
+}
+
+// AppendIntSlice appends the string form of each int in a slice to a caller-supplied buffer,
+// separated by `sep`, and returns the extended buffer
+// NOTE: Does not allocate as long as the buffer has enough capacity
+func AppendIntSlice(dst []byte, s []int, sep string) []byte {
+	for n, i := range s {
+		// Add separator between values
+		if n > 0 {
+			dst = append(dst, sep...)
+		}
+
+		dst = strconv.AppendInt(dst, int64(i), 10)
+	}
+
+	return dst
+}
+
 // Bool2String converts a bool to a string
 func Bool2String(v bool) string {
 	return strconv.FormatBool(v)
@@ -22,7 +62,8 @@ func Float642String(v float64) string {
 // IntSlice2StringSlice converts a slice of ints to a slice of strings
 func IntSlice2StringSlice(s []int) []string {
 	// Form return value
-	ret := make([]string, 0)
+	// NOTE: Pre-sized to avoid re-allocating while appending
+	ret := make([]string, 0, len(s))
 
 	// Check for empty input
 	if len(s) == 0 {
@@ -47,7 +88,7 @@ func Int2String(v int) string {
 
 // Int642String converts an int64 to a string
 func Int642String(v int64) string {
-	return strconv.Itoa(int(v))
+	return strconv.FormatInt(v, 10)
 }
 
 // Interface2String attempts to determine the underlying type of an interface and returns it as a string
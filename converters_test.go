@@ -2,12 +2,96 @@
 package goutils
 
 import (
+	// Standard lib
+	"testing"
+
 	// Third-party
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("converters.go", func() {
+	Describe("`AppendBool` method", func() {
+		It("Appends a bool to a buffer", func() {
+			// Call method
+			actual := AppendBool([]byte("foo:"), true)
+
+			// Verify return value
+			Expect(string(actual)).To(Equal("foo:true"))
+		})
+	})
+
+	Describe("`AppendFloat64` method", func() {
+		It("Appends a float64 to a buffer", func() {
+			// Call method
+			actual := AppendFloat64([]byte("foo:"), 234.567)
+
+			// Verify return value
+			Expect(string(actual)).To(Equal("foo:234.567"))
+		})
+	})
+
+	Describe("`AppendInt` method", func() {
+		It("Appends an int to a buffer", func() {
+			// Call method
+			actual := AppendInt([]byte("foo:"), -234)
+
+			// Verify return value
+			Expect(string(actual)).To(Equal("foo:-234"))
+		})
+	})
+
+	Describe("`AppendInt64` method", func() {
+		It("Appends an int64 to a buffer", func() {
+			// Call method
+			actual := AppendInt64([]byte("foo:"), 9007199254740993)
+
+			// Verify return value
+			Expect(string(actual)).To(Equal("foo:9007199254740993"))
+		})
+	})
+
+	Describe("`AppendIntSlice` method", func() {
+		var (
+			// Input for `AppendIntSlice` input
+			input map[*IntSlice2StringSliceTestData]string
+		)
+
+		BeforeEach(func() {
+			// Set input
+			// NOTE: Reuses the `IntSlice2StringSlice` test data struct, ignoring its output
+			input = map[*IntSlice2StringSliceTestData]string{
+				&IntSlice2StringSliceTestData{Input: []int{}}:           "",
+				&IntSlice2StringSliceTestData{Input: []int{1}}:          "1",
+				&IntSlice2StringSliceTestData{Input: []int{1, 22, 333}}: "1,22,333",
+			}
+		})
+
+		It("Appends an int slice to a buffer", func() {
+			// Loop through test data
+			for input, expected := range input {
+				// Call method
+				actual := AppendIntSlice(nil, input.Input, ",")
+
+				// Verify return value
+				Expect(string(actual)).To(Equal(expected))
+			}
+		})
+
+		It("Does not allocate when the buffer has enough capacity", func() {
+			// Create buffer and input
+			buf := make([]byte, 0, 64)
+			s := []int{123456, 234567, 345678}
+
+			// Verify no allocations occur
+			allocs := testing.AllocsPerRun(100, func() {
+				buf = AppendIntSlice(buf[:0], s, ",")
+			})
+
+			Expect(allocs).To(BeZero())
+		})
+	})
+
 	Describe("`Bool2String` method", func() {
 		var (
 			// Input for `Bool2String` input
@@ -92,6 +176,19 @@ var _ = Describe("converters.go", func() {
 				Expect(actual).To(Equal(input.Output))
 			}
 		})
+
+		It("Allocates the return value once", func() {
+			// Create input
+			s := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+			// Verify only the return value is allocated
+			// NOTE: Single-digit ints are converted without allocating
+			allocs := testing.AllocsPerRun(100, func() {
+				IntSlice2StringSlice(s)
+			})
+
+			Expect(allocs).To(Equal(1.0))
+		})
 	})
 
 	Describe("`Int2String` method", func() {
@@ -0,0 +1,310 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// IndexStyleDot keys slice elements like map keys (ex: `a.b.0`)
+	IndexStyleDot IndexStyle = iota
+	// IndexStyleBracket keys slice elements with brackets (ex: `a.b[0]`)
+	IndexStyleBracket
+)
+
+type (
+	// IndexStyle determines how slice indexes are represented within flattened keys
+	IndexStyle int
+
+	// FlattenConfig contains a set of configuration settings
+	// to be used with the `Flatten` and `Unflatten` methods
+	FlattenConfig struct {
+		Escape     string     // A string used to escape separators (and itself) within keys, or "" to disable escaping
+		IndexStyle IndexStyle // How slice indexes are represented
+		Separator  string     // The separator placed between nested keys
+	}
+
+	// flatNode represents a single level of a map being unflattened
+	flatNode struct {
+		children map[string]*flatNode // Child nodes, keyed by map key or slice index
+		keyed    bool                 // Whether any child was added by map key instead of slice index
+		leaf     bool                 // Whether the node holds a value instead of children
+		value    interface{}          // The node's value, if it's a leaf
+	}
+
+	// flatSegment represents a single part of a flattened key
+	flatSegment struct {
+		index bool   // Whether the segment is a slice index
+		key   string // The segment's (unescaped) key
+	}
+)
+
+// NewFlattenConfig returns a FlattenConfig struct with
+// default settings set for each of it's properties
+func NewFlattenConfig() *FlattenConfig {
+	return &FlattenConfig{
+		Escape:     "\\",
+		IndexStyle: IndexStyleDot,
+		Separator:  ".",
+	}
+}
+
+// Flatten converts a nested map (like those returned by `MapFromInterface`) into a single-level map,
+// joining nested keys with a separator (ex: `{"a":{"b":[1,2]}}` becomes `{"a.b.0":1,"a.b.1":2}`)
+// NOTE: A nil config uses the defaults from `NewFlattenConfig`. Empty maps and slices are kept as values
+func Flatten(m map[string]interface{}, c *FlattenConfig) map[string]interface{} {
+	if c == nil {
+		c = NewFlattenConfig()
+	}
+
+	// Form return value
+	ret := make(map[string]interface{}, len(m))
+
+	for k, v := range m {
+		c.flatten(ret, c.escape(k), v)
+	}
+
+	return ret
+}
+
+// Unflatten converts a single-level map created by `Flatten` back into a nested map,
+// returning an error if two keys conflict with each other (ex: `a` and `a.b`)
+// NOTE: A nil config uses the defaults from `NewFlattenConfig`. Levels whose keys are all
+// slice indexes running from 0 become slices, all other levels become maps
+func Unflatten(m map[string]interface{}, c *FlattenConfig) (map[string]interface{}, error) {
+	if c == nil {
+		c = NewFlattenConfig()
+	}
+
+	root := &flatNode{keyed: true}
+
+	for k, v := range m {
+		segments, err := c.split(k)
+		if err != nil {
+			return nil, err
+		}
+
+		// Walk to the node represented by the key, creating nodes as needed
+		node := root
+		for _, segment := range segments {
+			if node.leaf {
+				return nil, fmt.Errorf("Key '%s' conflicts with another key", k)
+			}
+
+			if node.children == nil {
+				node.children = make(map[string]*flatNode)
+			}
+
+			if !segment.index {
+				node.keyed = true
+			}
+
+			child, ok := node.children[segment.key]
+			if !ok {
+				child = &flatNode{}
+				node.children[segment.key] = child
+			}
+
+			node = child
+		}
+
+		if node.leaf || node.children != nil {
+			return nil, fmt.Errorf("Key '%s' conflicts with another key", k)
+		}
+
+		node.leaf = true
+		node.value = v
+	}
+
+	// NOTE: The root is always a map
+	ret := make(map[string]interface{}, len(root.children))
+	for k, child := range root.children {
+		ret[k] = child.build()
+	}
+
+	return ret, nil
+}
+
+// build converts a node and it's children into a value
+func (n *flatNode) build() interface{} {
+	if n.leaf {
+		return n.value
+	}
+
+	// Use a slice when all keys are contiguous slice indexes
+	if !n.keyed {
+		ret := make([]interface{}, len(n.children))
+		for k, child := range n.children {
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(ret) {
+				ret = nil
+				break
+			}
+
+			ret[i] = child.build()
+		}
+
+		if ret != nil {
+			return ret
+		}
+	}
+
+	ret := make(map[string]interface{}, len(n.children))
+	for k, child := range n.children {
+		ret[k] = child.build()
+	}
+
+	return ret
+}
+
+// escape escapes any separators, escape strings and (when using bracket-style indexes) brackets within a key
+func (c *FlattenConfig) escape(key string) string {
+	if c.Escape == "" {
+		return key
+	}
+
+	var b strings.Builder
+
+	for i := 0; i < len(key); {
+		rest := key[i:]
+
+		switch {
+		case strings.HasPrefix(rest, c.Escape):
+			b.WriteString(c.Escape + c.Escape)
+			i += len(c.Escape)
+		case c.Separator != "" && strings.HasPrefix(rest, c.Separator):
+			b.WriteString(c.Escape + c.Separator)
+			i += len(c.Separator)
+		case c.IndexStyle == IndexStyleBracket && (rest[0] == '[' || rest[0] == ']'):
+			b.WriteString(c.Escape)
+			b.WriteByte(rest[0])
+			i++
+		default:
+			b.WriteByte(rest[0])
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+// flatten recursively adds the values of a nested map or slice to a flat map
+func (c *FlattenConfig) flatten(ret map[string]interface{}, prefix string, v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if len(t) == 0 {
+			ret[prefix] = t
+			return
+		}
+
+		for k, child := range t {
+			c.flatten(ret, prefix+c.Separator+c.escape(k), child)
+		}
+	case []interface{}:
+		if len(t) == 0 {
+			ret[prefix] = t
+			return
+		}
+
+		for i, child := range t {
+			if c.IndexStyle == IndexStyleBracket {
+				c.flatten(ret, prefix+"["+Int2String(i)+"]", child)
+			} else {
+				c.flatten(ret, prefix+c.Separator+Int2String(i), child)
+			}
+		}
+	default:
+		ret[prefix] = t
+	}
+}
+
+// split splits a flattened key into it's segments, unescaping each one
+func (c *FlattenConfig) split(key string) ([]flatSegment, error) {
+	var (
+		// Segments found so far
+		segments []flatSegment
+		// The segment currently being built
+		current strings.Builder
+		// Whether the previous segment was a bracket-style index
+		afterIndex bool
+	)
+
+	// Adds the segment currently being built
+	add := func() {
+		k := current.String()
+		current.Reset()
+
+		// NOTE: With dot-style indexes, any numeric segment is treated as a slice index
+		_, err := strconv.Atoi(k)
+		segments = append(segments, flatSegment{
+			index: c.IndexStyle == IndexStyleDot && err == nil,
+			key:   k,
+		})
+	}
+
+	for i := 0; i < len(key); {
+		rest := key[i:]
+
+		switch {
+		case c.Escape != "" && strings.HasPrefix(rest, c.Escape):
+			i += len(c.Escape)
+
+			// Take the escaped separator, escape string or byte literally
+			switch {
+			case i >= len(key):
+				return nil, fmt.Errorf("Key '%s' ends with an escape", key)
+			case strings.HasPrefix(key[i:], c.Escape):
+				current.WriteString(c.Escape)
+				i += len(c.Escape)
+			case c.Separator != "" && strings.HasPrefix(key[i:], c.Separator):
+				current.WriteString(c.Separator)
+				i += len(c.Separator)
+			default:
+				current.WriteByte(key[i])
+				i++
+			}
+		case c.Separator != "" && strings.HasPrefix(rest, c.Separator):
+			// The separator after a bracket-style index doesn't end a segment
+			if !afterIndex {
+				add()
+			}
+
+			i += len(c.Separator)
+		case c.IndexStyle == IndexStyleBracket && rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("Key '%s' contains an unclosed bracket", key)
+			}
+
+			// Finish the segment before the index
+			if !afterIndex {
+				add()
+			}
+
+			index := rest[1:end]
+			if n, err := strconv.Atoi(index); err != nil || n < 0 {
+				return nil, fmt.Errorf("Key '%s' contains an invalid index '%s'", key, index)
+			}
+
+			segments = append(segments, flatSegment{index: true, key: index})
+			i += end + 1
+			afterIndex = true
+
+			continue
+		default:
+			current.WriteByte(rest[0])
+			i++
+		}
+
+		afterIndex = false
+	}
+
+	if !afterIndex {
+		add()
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,177 @@
+// Tests the flatten.go file
+package goutils
+
+import (
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("flatten.go", func() {
+	var (
+		// Nested map used as input for flattening and output for unflattening
+		nested map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		// Set nested map
+		nested = map[string]interface{}{
+			"a": map[string]interface{}{
+				"b": []interface{}{1, map[string]interface{}{"c": "foo"}},
+			},
+			"d.e":  true,
+			"f":    map[string]interface{}{},
+			"g\\h": []interface{}{},
+			"i[0]": nil,
+		}
+	})
+
+	Describe("`NewFlattenConfig` method", func() {
+		It("Returns a valid flatten config struct", func() {
+			// Call method
+			c := NewFlattenConfig()
+
+			// Verify flatten config was properly created and returned
+			Expect(c.Escape).To(Equal("\\"))
+			Expect(c.IndexStyle).To(Equal(IndexStyleDot))
+			Expect(c.Separator).To(Equal("."))
+		})
+	})
+
+	Describe("`Flatten` method", func() {
+		Context("When using the default config", func() {
+			It("Flattens the map using dot-style indexes and escaped keys", func() {
+				// Call method
+				actual := Flatten(nested, nil)
+
+				// Verify return value
+				Expect(actual).To(Equal(map[string]interface{}{
+					"a.b.0":   1,
+					"a.b.1.c": "foo",
+					"d\\.e":   true,
+					"f":       map[string]interface{}{},
+					"g\\\\h":  []interface{}{},
+					"i[0]":    nil,
+				}))
+			})
+		})
+
+		Context("When using bracket-style indexes and a custom separator", func() {
+			It("Flattens the map using bracket-style indexes and escaped keys", func() {
+				// Call method
+				actual := Flatten(nested, &FlattenConfig{
+					Escape:     "\\",
+					IndexStyle: IndexStyleBracket,
+					Separator:  "/",
+				})
+
+				// Verify return value
+				Expect(actual).To(Equal(map[string]interface{}{
+					"a/b[0]":   1,
+					"a/b[1]/c": "foo",
+					"d.e":      true,
+					"f":        map[string]interface{}{},
+					"g\\\\h":   []interface{}{},
+					"i\\[0\\]": nil,
+				}))
+			})
+		})
+
+		Context("When escaping is disabled", func() {
+			It("Leaves keys as-is", func() {
+				// Call method
+				actual := Flatten(map[string]interface{}{"d.e": map[string]interface{}{"f": 1}}, &FlattenConfig{Separator: "."})
+
+				// Verify return value
+				Expect(actual).To(Equal(map[string]interface{}{"d.e.f": 1}))
+			})
+		})
+	})
+
+	Describe("`Unflatten` method", func() {
+		Context("When using the default config", func() {
+			It("Reverses `Flatten`", func() {
+				// Call method
+				actual, err := Unflatten(Flatten(nested, nil), nil)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal(nested))
+			})
+		})
+
+		Context("When using bracket-style indexes", func() {
+			It("Reverses `Flatten`", func() {
+				// Create config
+				c := &FlattenConfig{Escape: "\\", IndexStyle: IndexStyleBracket, Separator: "."}
+
+				// Call method
+				actual, err := Unflatten(Flatten(nested, c), c)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal(nested))
+			})
+
+			It("Supports nested slices", func() {
+				// Call method
+				actual, err := Unflatten(map[string]interface{}{
+					"a[0][0]": 1,
+					"a[0][1]": 2,
+					"a[1].b":  3,
+				}, &FlattenConfig{IndexStyle: IndexStyleBracket, Separator: "."})
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal(map[string]interface{}{
+					"a": []interface{}{
+						[]interface{}{1, 2},
+						map[string]interface{}{"b": 3},
+					},
+				}))
+			})
+		})
+
+		Context("When slice indexes aren't contiguous", func() {
+			It("Returns a map instead of a slice", func() {
+				// Call method
+				actual, err := Unflatten(map[string]interface{}{"a.0": 1, "a.2": 2}, nil)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal(map[string]interface{}{
+					"a": map[string]interface{}{"0": 1, "2": 2},
+				}))
+			})
+		})
+
+		Context("When keys are invalid or conflict", func() {
+			var (
+				// Input for `Unflatten` input
+				input []map[string]interface{}
+			)
+
+			BeforeEach(func() {
+				// Set input
+				input = []map[string]interface{}{
+					{"a": 1, "a.b": 2},
+					{"a.b.c": 1, "a.b": 2},
+					{"a\\": 1},
+					{"a[0": 1},
+					{"a[b]": 1},
+				}
+			})
+
+			It("Returns an error", func() {
+				// Loop through test data
+				for _, input := range input {
+					// Call method
+					_, err := Unflatten(input, &FlattenConfig{Escape: "\\", IndexStyle: IndexStyleBracket, Separator: "."})
+
+					// Verify return value
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
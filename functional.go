@@ -0,0 +1,210 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"fmt"
+)
+
+type (
+	// Pair holds two values, as produced by `Zip`
+	Pair[A, B any] struct {
+		First  A // The value from the first slice
+		Second B // The value from the second slice
+	}
+)
+
+// CountBy returns the number of values in a slice for each key returned by a function
+func CountBy[T any, K comparable](s []T, fn func(T) K) map[K]int {
+	// Form return value
+	ret := make(map[K]int)
+
+	for _, v := range s {
+		ret[fn(v)]++
+	}
+
+	return ret
+}
+
+// Filter returns the values of a slice that satisfy a function
+func Filter[T any](s []T, fn func(T) bool) []T {
+	// Form return value
+	ret := make([]T, 0)
+
+	for _, v := range s {
+		if fn(v) {
+			ret = append(ret, v)
+		}
+	}
+
+	return ret
+}
+
+// FilterErr returns the values of a slice that satisfy a function,
+// stopping at and returning the first error encountered
+// NOTE: Errors are wrapped with the index of the value that caused them
+func FilterErr[T any](s []T, fn func(T) (bool, error)) ([]T, error) {
+	// Form return value
+	ret := make([]T, 0)
+
+	for i, v := range s {
+		ok, err := fn(v)
+		if err != nil {
+			return nil, fmt.Errorf("Error filtering value at index %d: %w", i, err)
+		}
+
+		if ok {
+			ret = append(ret, v)
+		}
+	}
+
+	return ret, nil
+}
+
+// FlatMap applies a function to each value of a slice and concatenates the resulting slices
+func FlatMap[T, U any](s []T, fn func(T) []U) []U {
+	// Form return value
+	ret := make([]U, 0, len(s))
+
+	for _, v := range s {
+		ret = append(ret, fn(v)...)
+	}
+
+	return ret
+}
+
+// GroupBy groups the values of a slice by the key returned by a function,
+// preserving the order of values within each group
+func GroupBy[T any, K comparable](s []T, fn func(T) K) map[K][]T {
+	// Form return value
+	ret := make(map[K][]T)
+
+	for _, v := range s {
+		k := fn(v)
+		ret[k] = append(ret[k], v)
+	}
+
+	return ret
+}
+
+// KeyBy returns a map of the values of a slice keyed by the key returned by a function
+// NOTE: When multiple values share a key, the last one wins
+func KeyBy[T any, K comparable](s []T, fn func(T) K) map[K]T {
+	// Form return value
+	ret := make(map[K]T, len(s))
+
+	for _, v := range s {
+		ret[fn(v)] = v
+	}
+
+	return ret
+}
+
+// Map applies a function to each value of a slice and returns the results
+func Map[T, U any](s []T, fn func(T) U) []U {
+	// Form return value
+	ret := make([]U, len(s))
+
+	for i, v := range s {
+		ret[i] = fn(v)
+	}
+
+	return ret
+}
+
+// MapErr applies a function to each value of a slice and returns the results,
+// stopping at and returning the first error encountered
+// NOTE: Errors are wrapped with the index of the value that caused them
+func MapErr[T, U any](s []T, fn func(T) (U, error)) ([]U, error) {
+	// Form return value
+	ret := make([]U, len(s))
+
+	for i, v := range s {
+		u, err := fn(v)
+		if err != nil {
+			return nil, fmt.Errorf("Error mapping value at index %d: %w", i, err)
+		}
+
+		ret[i] = u
+	}
+
+	return ret, nil
+}
+
+// Partition splits a slice into the values that satisfy a function and those that don't
+func Partition[T any](s []T, fn func(T) bool) ([]T, []T) {
+	// Form return values
+	matched, unmatched := make([]T, 0), make([]T, 0)
+
+	for _, v := range s {
+		if fn(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+
+	return matched, unmatched
+}
+
+// Reduce combines the values of a slice into a single value, starting from an initial value
+func Reduce[T, U any](s []T, initial U, fn func(U, T) U) U {
+	acc := initial
+
+	for _, v := range s {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
+
+// ReduceErr combines the values of a slice into a single value, starting from an initial value,
+// stopping at and returning the first error encountered
+// NOTE: Errors are wrapped with the index of the value that caused them
+func ReduceErr[T, U any](s []T, initial U, fn func(U, T) (U, error)) (U, error) {
+	acc := initial
+
+	for i, v := range s {
+		var err error
+		if acc, err = fn(acc, v); err != nil {
+			var zero U
+			return zero, fmt.Errorf("Error reducing value at index %d: %w", i, err)
+		}
+	}
+
+	return acc, nil
+}
+
+// Reject returns the values of a slice that don't satisfy a function
+func Reject[T any](s []T, fn func(T) bool) []T {
+	return Filter(s, func(v T) bool {
+		return !fn(v)
+	})
+}
+
+// Unzip splits a slice of pairs into two slices
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	// Form return values
+	a, b := make([]A, len(pairs)), make([]B, len(pairs))
+
+	for i, p := range pairs {
+		a[i], b[i] = p.First, p.Second
+	}
+
+	return a, b
+}
+
+// Zip combines two slices into a slice of pairs
+// NOTE: The result is as long as the shorter of the two slices
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+
+	// Form return value
+	ret := make([]Pair[A, B], n)
+
+	for i := 0; i < n; i++ {
+		ret[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+
+	return ret
+}
@@ -0,0 +1,167 @@
+// Tests the functional.go file
+package goutils
+
+import (
+	// Standard lib
+	"errors"
+	"strconv"
+	"strings"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("functional.go", func() {
+	var (
+		// Input for functional methods
+		input []string
+		// Function to determine if a string is longer than three characters
+		long = func(s string) bool { return len(s) > 3 }
+		// Function to parse a string as an int64
+		parse = func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) }
+	)
+
+	BeforeEach(func() {
+		// Set input
+		input = []string{"foo", "barbaz", "qux", "quux"}
+	})
+
+	Describe("`Map` method", func() {
+		It("Applies a function to each value", func() {
+			Expect(Map(input, strings.ToUpper)).To(Equal([]string{"FOO", "BARBAZ", "QUX", "QUUX"}))
+			Expect(Map([]int{1, 2}, Int2String)).To(Equal([]string{"1", "2"}))
+		})
+	})
+
+	Describe("`MapErr` method", func() {
+		Context("When every value can be mapped", func() {
+			It("Returns the results", func() {
+				// Call method
+				actual, err := MapErr([]string{"1", "22"}, parse)
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal([]int64{1, 22}))
+			})
+		})
+
+		Context("When a value can't be mapped", func() {
+			It("Returns an error", func() {
+				// Call method
+				actual, err := MapErr([]string{"1", "foo", "bar"}, parse)
+
+				// Verify return values
+				Expect(actual).To(BeNil())
+				Expect(err).To(MatchError(ContainSubstring("index 1")))
+				Expect(errors.Is(err, strconv.ErrSyntax)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("`Filter` and `Reject` methods", func() {
+		It("Keeps or drops values satisfying a function", func() {
+			Expect(Filter(input, long)).To(Equal([]string{"barbaz", "quux"}))
+			Expect(Reject(input, long)).To(Equal([]string{"foo", "qux"}))
+			Expect(Filter(nil, long)).To(BeEmpty())
+		})
+	})
+
+	Describe("`FilterErr` method", func() {
+		It("Keeps values satisfying a function or returns an error", func() {
+			// Create function
+			positive := func(s string) (bool, error) {
+				i, err := parse(s)
+				return i > 0, err
+			}
+
+			// Call method with valid input
+			actual, err := FilterErr([]string{"1", "-1", "2"}, positive)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual).To(Equal([]string{"1", "2"}))
+
+			// Call method with invalid input
+			_, err = FilterErr([]string{"1", "foo"}, positive)
+			Expect(err).To(MatchError(ContainSubstring("index 1")))
+		})
+	})
+
+	Describe("`Reduce` and `ReduceErr` methods", func() {
+		It("Combines values into a single value", func() {
+			// Call method
+			actual := Reduce(input, 0, func(acc int, s string) int { return acc + len(s) })
+
+			// Verify return value
+			Expect(actual).To(Equal(16))
+		})
+
+		It("Combines values or returns an error", func() {
+			// Create function
+			sum := func(acc int64, s string) (int64, error) {
+				i, err := parse(s)
+				return acc + i, err
+			}
+
+			// Call method with valid input
+			actual, err := ReduceErr([]string{"1", "2", "3"}, int64(0), sum)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual).To(Equal(int64(6)))
+
+			// Call method with invalid input
+			actual, err = ReduceErr([]string{"1", "foo"}, int64(0), sum)
+			Expect(err).To(MatchError(ContainSubstring("index 1")))
+			Expect(actual).To(BeZero())
+		})
+	})
+
+	Describe("`FlatMap` method", func() {
+		It("Concatenates the slices returned by a function", func() {
+			Expect(FlatMap([]string{"a,b", "c"}, func(s string) []string {
+				return strings.Split(s, ",")
+			})).To(Equal([]string{"a", "b", "c"}))
+		})
+	})
+
+	Describe("`GroupBy`, `KeyBy` and `CountBy` methods", func() {
+		It("Groups, keys and counts values", func() {
+			Expect(GroupBy(input, long)).To(Equal(map[bool][]string{
+				true:  {"barbaz", "quux"},
+				false: {"foo", "qux"},
+			}))
+			Expect(KeyBy(input, long)).To(Equal(map[bool]string{true: "quux", false: "qux"}))
+			Expect(CountBy(input, long)).To(Equal(map[bool]int{true: 2, false: 2}))
+		})
+	})
+
+	Describe("`Partition` method", func() {
+		It("Splits values by a function", func() {
+			// Call method
+			matched, unmatched := Partition(input, long)
+
+			// Verify return values
+			Expect(matched).To(Equal([]string{"barbaz", "quux"}))
+			Expect(unmatched).To(Equal([]string{"foo", "qux"}))
+		})
+	})
+
+	Describe("`Zip` and `Unzip` methods", func() {
+		It("Combines and splits slices", func() {
+			// Call method
+			pairs := Zip(input, []int{1, 2, 3})
+
+			// Verify return value
+			Expect(pairs).To(Equal([]Pair[string, int]{
+				{First: "foo", Second: 1},
+				{First: "barbaz", Second: 2},
+				{First: "qux", Second: 3},
+			}))
+
+			// Call method
+			a, b := Unzip(pairs)
+
+			// Verify return values
+			Expect(a).To(Equal([]string{"foo", "barbaz", "qux"}))
+			Expect(b).To(Equal([]int{1, 2, 3}))
+		})
+	})
+})
@@ -0,0 +1,284 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"sort"
+	"strings"
+)
+
+const (
+	// MatchDamerauLevenshtein ranks matches by edit distance, counting adjacent transpositions as a single edit
+	MatchDamerauLevenshtein MatchAlgorithm = iota
+	// MatchLevenshtein ranks matches by edit distance
+	MatchLevenshtein
+	// MatchJaroWinkler ranks matches by Jaro-Winkler similarity, which favors strings with a common prefix
+	MatchJaroWinkler
+)
+
+type (
+	// MatchAlgorithm determines how strings are compared when finding close matches
+	MatchAlgorithm int
+
+	// MatchConfig contains a set of configuration settings
+	// to be used with the methods that find close matches
+	MatchConfig struct {
+		Algorithm     MatchAlgorithm // The algorithm used to compare strings
+		CaseSensitive bool           // Whether differences in case count as differences
+		MaxDistance   int            // The maximum edit distance for edit distance algorithms, or -1 for no limit
+		MinSimilarity float64        // The minimum similarity (from 0 to 1) for a match
+		PrefixMatch   bool           // Whether values starting with the needle always match, ranked first
+	}
+
+	// fuzzyMatch represents a single candidate match and how it ranks
+	fuzzyMatch struct {
+		distance   int     // The edit distance to the needle, for edit distance algorithms
+		prefix     bool    // Whether the value starts with the needle
+		similarity float64 // The similarity to the needle
+		value      string  // The matched value
+	}
+)
+
+// NewMatchConfig returns a MatchConfig struct with
+// default settings set for each of it's properties
+// NOTE: The defaults suit CLI typo suggestions
+func NewMatchConfig() *MatchConfig {
+	return &MatchConfig{
+		Algorithm:     MatchDamerauLevenshtein,
+		CaseSensitive: false,
+		MaxDistance:   2,
+		MinSimilarity: 0,
+		PrefixMatch:   true,
+	}
+}
+
+// ClosestMatches returns (at most) the `n` values of a slice that most closely match a string,
+// best match first, for use in "did you mean" suggestions
+// NOTE: Uses the defaults from `NewMatchConfig`, see `ClosestMatchesConfig` for more options
+func ClosestMatches(needle string, haystack []string, n int) []string {
+	return ClosestMatchesConfig(needle, haystack, n, nil)
+}
+
+// ClosestMatchesConfig returns (at most) the `n` values of a slice that most closely match a string
+// using the settings of a config, best match first
+// NOTE: Ties are broken by the order of the haystack, and duplicate values are dropped.
+// A nil config uses the defaults from `NewMatchConfig`
+func ClosestMatchesConfig(needle string, haystack []string, n int, c *MatchConfig) []string {
+	if c == nil {
+		c = NewMatchConfig()
+	}
+
+	// Normalize case if needed
+	normalize := func(s string) string {
+		if c.CaseSensitive {
+			return s
+		}
+
+		return strings.ToLower(s)
+	}
+
+	target := normalize(needle)
+	matches := make([]fuzzyMatch, 0)
+
+	for _, value := range Uniq(haystack) {
+		candidate := normalize(value)
+		m := fuzzyMatch{
+			prefix: c.PrefixMatch && target != "" && strings.HasPrefix(candidate, target),
+			value:  value,
+		}
+
+		// Score the candidate
+		switch c.Algorithm {
+		case MatchJaroWinkler:
+			m.similarity = JaroWinklerSimilarity(target, candidate)
+		default:
+			if c.Algorithm == MatchLevenshtein {
+				m.distance = LevenshteinDistance(target, candidate)
+			} else {
+				m.distance = DamerauLevenshteinDistance(target, candidate)
+			}
+
+			m.similarity = distance2Similarity(m.distance, target, candidate)
+		}
+
+		// Skip candidates outside of the thresholds, unless they're prefix matches
+		if !m.prefix {
+			if c.Algorithm != MatchJaroWinkler && c.MaxDistance >= 0 && m.distance > c.MaxDistance {
+				continue
+			}
+
+			if m.similarity < c.MinSimilarity {
+				continue
+			}
+		}
+
+		matches = append(matches, m)
+	}
+
+	// Rank matches: prefix matches first, then by similarity
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].prefix != matches[j].prefix {
+			return matches[i].prefix
+		}
+
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+
+		return matches[i].similarity > matches[j].similarity
+	})
+
+	// Form return value
+	ret := make([]string, 0, min(max(n, 0), len(matches)))
+
+	for _, m := range matches {
+		if len(ret) >= n {
+			break
+		}
+
+		ret = append(ret, m.value)
+	}
+
+	return ret
+}
+
+// DamerauLevenshteinDistance returns the number of single-character insertions, deletions, substitutions
+// and adjacent transpositions needed to turn one string into another
+// NOTE: Uses the optimal string alignment variant, where no substring is edited more than once
+func DamerauLevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Keep the previous two rows of the distance matrix
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+
+			// Count adjacent transpositions as a single edit
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(rb)]
+}
+
+// JaroWinklerSimilarity returns the Jaro-Winkler similarity of two strings,
+// from 0 (no similarity) to 1 (identical)
+func JaroWinklerSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	// Find matching characters within the match window
+	window := max(max(len(ra), len(rb))/2-1, 0)
+	matchedA, matchedB := make([]bool, len(ra)), make([]bool, len(rb))
+	matches := 0
+
+	for i := range ra {
+		for j := max(0, i-window); j < min(len(rb), i+window+1); j++ {
+			if !matchedB[j] && ra[i] == rb[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	// Count matching characters that are out of order
+	transpositions, j := 0, 0
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+
+		for !matchedB[j] {
+			j++
+		}
+
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions/2))/m) / 3
+
+	// Boost strings with a common prefix of up to 4 characters
+	prefix := 0
+	for prefix < min(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// LevenshteinDistance returns the number of single-character insertions, deletions and substitutions
+// needed to turn one string into another
+func LevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Keep the previous row of the distance matrix
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// distance2Similarity converts an edit distance between two strings into a similarity from 0 to 1
+func distance2Similarity(distance int, a, b string) float64 {
+	longest := max(len([]rune(a)), len([]rune(b)))
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(distance)/float64(longest)
+}
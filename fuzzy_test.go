@@ -0,0 +1,113 @@
+// Tests the fuzzy.go file
+package goutils
+
+import (
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fuzzy.go", func() {
+	var (
+		// Command names to match against
+		commands []string
+	)
+
+	BeforeEach(func() {
+		// Set command names
+		commands = []string{"status", "stash", "commit", "checkout", "cherry-pick", "config", "clone", "status"}
+	})
+
+	Describe("`NewMatchConfig` method", func() {
+		It("Returns a valid match config struct", func() {
+			// Call method
+			c := NewMatchConfig()
+
+			// Verify match config was properly created and returned
+			Expect(c.Algorithm).To(Equal(MatchDamerauLevenshtein))
+			Expect(c.CaseSensitive).To(BeFalse())
+			Expect(c.MaxDistance).To(Equal(2))
+			Expect(c.MinSimilarity).To(BeZero())
+			Expect(c.PrefixMatch).To(BeTrue())
+		})
+	})
+
+	Describe("`LevenshteinDistance` method", func() {
+		It("Returns the edit distance between two strings", func() {
+			Expect(LevenshteinDistance("kitten", "sitting")).To(Equal(3))
+			Expect(LevenshteinDistance("", "abc")).To(Equal(3))
+			Expect(LevenshteinDistance("abc", "")).To(Equal(3))
+			Expect(LevenshteinDistance("ca", "ac")).To(Equal(2))
+			Expect(LevenshteinDistance("héllo", "hello")).To(Equal(1))
+		})
+	})
+
+	Describe("`DamerauLevenshteinDistance` method", func() {
+		It("Returns the edit distance between two strings, counting transpositions", func() {
+			Expect(DamerauLevenshteinDistance("kitten", "sitting")).To(Equal(3))
+			Expect(DamerauLevenshteinDistance("ca", "ac")).To(Equal(1))
+			Expect(DamerauLevenshteinDistance("stauts", "status")).To(Equal(1))
+			Expect(DamerauLevenshteinDistance("", "")).To(Equal(0))
+		})
+	})
+
+	Describe("`JaroWinklerSimilarity` method", func() {
+		It("Returns the similarity of two strings", func() {
+			Expect(JaroWinklerSimilarity("MARTHA", "MARHTA")).To(BeNumerically("~", 0.961, 0.001))
+			Expect(JaroWinklerSimilarity("DIXON", "DICKSONX")).To(BeNumerically("~", 0.813, 0.001))
+			Expect(JaroWinklerSimilarity("abc", "abc")).To(Equal(1.0))
+			Expect(JaroWinklerSimilarity("abc", "xyz")).To(BeZero())
+			Expect(JaroWinklerSimilarity("", "")).To(Equal(1.0))
+			Expect(JaroWinklerSimilarity("abc", "")).To(BeZero())
+		})
+	})
+
+	Describe("`ClosestMatches` method", func() {
+		It("Suggests close matches, best first", func() {
+			Expect(ClosestMatches("stauts", commands, 3)).To(Equal([]string{"status"}))
+			Expect(ClosestMatches("comit", commands, 3)).To(Equal([]string{"commit"}))
+			Expect(ClosestMatches("STASH", commands, 3)).To(Equal([]string{"stash"}))
+		})
+
+		It("Ranks prefix matches first", func() {
+			Expect(ClosestMatches("che", commands, 5)).To(Equal([]string{"checkout", "cherry-pick"}))
+			Expect(ClosestMatches("c", commands, 2)).To(Equal([]string{"clone", "commit"}))
+		})
+
+		It("Returns nothing when no values are close enough", func() {
+			Expect(ClosestMatches("xyzzy", commands, 3)).To(BeEmpty())
+			Expect(ClosestMatches("status", commands, 0)).To(BeEmpty())
+		})
+	})
+
+	Describe("`ClosestMatchesConfig` method", func() {
+		It("Supports Levenshtein distances", func() {
+			// Create config
+			c := NewMatchConfig()
+			c.Algorithm = MatchLevenshtein
+			c.MaxDistance = 1
+
+			// Verify transpositions count as two edits
+			Expect(ClosestMatchesConfig("stauts", commands, 3, c)).To(BeEmpty())
+		})
+
+		It("Supports Jaro-Winkler similarities", func() {
+			// Create config
+			c := &MatchConfig{Algorithm: MatchJaroWinkler, MinSimilarity: 0.85}
+
+			// Verify return value
+			Expect(ClosestMatchesConfig("stats", commands, 3, c)).To(Equal([]string{"status", "stash"}))
+		})
+
+		It("Supports case-sensitive matching", func() {
+			// Create config
+			c := NewMatchConfig()
+			c.CaseSensitive = true
+			c.PrefixMatch = false
+
+			// Verify return value
+			Expect(ClosestMatchesConfig("CLONE", commands, 3, c)).To(BeEmpty())
+			Expect(ClosestMatchesConfig("clone", commands, 3, c)).To(Equal([]string{"clone"}))
+		})
+	})
+})
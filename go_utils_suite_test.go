@@ -3,11 +3,16 @@ package goutils
 
 import (
 	// Standard lib
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	// Third-party
 	. "github.com/onsi/ginkgo"
@@ -21,13 +26,97 @@ type (
 		Input  []int
 		Output []string
 	}
+	// Struct representing the response of the "echo" mock server
+	EchoResponse struct {
+		Body    string
+		Cookies []*http.Cookie
+		Headers http.Header
+		Method  string
+		Query   url.Values
+	}
+	// Function type allowing a function to be used as an `http.RoundTripper`
+	roundTripperFunc func(*http.Request) (*http.Response, error)
+	// Struct representing a response body that tracks how it's used
+	trackingBody struct {
+		io.Reader
+		Closed    bool
+		BytesRead int64
+	}
 	// Struct representing SliceContains input data
 	SliceContainsTestData struct {
 		Needle   string
 		Haystack []string
 	}
+	// Struct representing Struct2Map input data
+	Struct2MapTestData struct {
+		Struct2MapEmbeddedTestData
+		*Struct2MapEmbeddedPtrTestData
+		Name     string            `json:"name"`
+		Count    int               `json:"count,omitempty"`
+		Tags     []string          `json:"tags"`
+		Created  time.Time         `json:"created"`
+		Nested   *Struct2MapNested `json:"nested,omitempty"`
+		Ignored  string            `json:"-"`
+		Untagged bool
+		private  string
+	}
+	// Struct representing an embedded struct within Struct2Map input data
+	Struct2MapEmbeddedTestData struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"` // NOTE: Shadowed by the outer struct's field
+	}
+	// Struct representing an embedded struct pointer within Struct2Map input data
+	Struct2MapEmbeddedPtrTestData struct {
+		Page int `json:"page"`
+	}
+	// Struct representing a nested struct within Struct2Map input data
+	Struct2MapNested struct {
+		Value float64 `json:"value"`
+	}
+	// Struct representing Struct2Map input data with conflicting promoted fields
+	Struct2MapConflictTestData struct {
+		struct2MapUnexportedTestData
+		Struct2MapLabelTestData
+		Bytes []byte `json:"bytes"`
+	}
+	// Struct representing an embedded struct with conflicting fields within Struct2Map input data
+	Struct2MapLabelTestData struct {
+		Label string
+		Title string `json:"Title"`
+	}
+	// Struct representing an embedded struct of an unexported type within Struct2Map input data
+	struct2MapUnexportedTestData struct {
+		Label string // NOTE: Conflicts with `Struct2MapLabelTestData.Label`
+		Title string // NOTE: Loses to the tagged `Struct2MapLabelTestData.Title`
+		Token string `json:"token"`
+	}
 )
 
+// RoundTrip implements `http.RoundTripper`
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTrackingBody returns a trackingBody containing `size` bytes
+func newTrackingBody(size int) *trackingBody {
+	return &trackingBody{Reader: bytes.NewReader(make([]byte, size))}
+}
+
+// Read implements `io.Reader`, tracking the number of bytes read
+func (b *trackingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	b.BytesRead += int64(n)
+
+	return n, err
+}
+
+// Close implements `io.Closer`, tracking whether the body was closed
+func (b *trackingBody) Close() error {
+	b.Closed = true
+
+	return nil
+}
+
 // getMockServer returns a httptest server with the desired handler function
 // based on the key passed in
 func getMockServer(key string) *httptest.Server {
@@ -41,6 +130,40 @@ func getMockServer(key string) *httptest.Server {
 			w.Header().Set("Content-Type", "application/json")
 			fmt.Fprintln(w, `{"code":400}`)
 		})
+	case "echo":
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Read body
+			body, _ := ioutil.ReadAll(r.Body)
+
+			// Write headers and body describing the request
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			json.NewEncoder(w).Encode(&EchoResponse{
+				Body:    string(body),
+				Cookies: r.Cookies(),
+				Headers: r.Header,
+				Method:  r.Method,
+				Query:   r.URL.Query(),
+			})
+		})
+	case "slow-body":
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Write headers, then wait to write the body until the request is cancelled
+			w.WriteHeader(200)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		})
+	case "stream":
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Write headers, then write the body in chunks with a delay between each
+			w.WriteHeader(200)
+
+			for i := 0; i < 5; i++ {
+				fmt.Fprint(w, "chunk")
+				w.(http.Flusher).Flush()
+				time.Sleep(10 * time.Millisecond)
+			}
+		})
 	case "timeout":
 		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			select {} // NOTE: Allows timeout error to occur within client.Do calls
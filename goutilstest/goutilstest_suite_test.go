@@ -0,0 +1,20 @@
+// Test suite setup for the goutilstest package
+package goutilstest
+
+import (
+	// Standard lib
+	"testing"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Tests the goutilstest package
+func TestGoUtilsTest(t *testing.T) {
+	// Register gomega fail handler
+	RegisterFailHandler(Fail)
+
+	// Have go's testing package run package specs
+	RunSpecs(t, "goutilstest suite")
+}
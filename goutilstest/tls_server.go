@@ -0,0 +1,163 @@
+// Package goutilstest contains helpers for testing code that uses the goutils package
+package goutilstest
+
+import (
+	// Standard lib
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	// Local
+	goutils "github.com/marksost/go-utils"
+)
+
+type (
+	// TLSTestServer is an `httptest` TLS server using certificates signed by a generated CA,
+	// along with a client certificate signed by the same CA, for testing (mutual) TLS requests
+	TLSTestServer struct {
+		*httptest.Server
+
+		CAPEM         []byte // The PEM encoded certificate of the generated CA
+		ClientCertPEM []byte // The PEM encoded client certificate
+		ClientKeyPEM  []byte // The PEM encoded private key of the client certificate
+	}
+
+	// testCertificate is a generated certificate, along with it's private key
+	testCertificate struct {
+		cert    *x509.Certificate // The parsed certificate
+		certPEM []byte            // The PEM encoded certificate
+		key     *ecdsa.PrivateKey // The certificate's private key
+		keyPEM  []byte            // The PEM encoded private key
+	}
+)
+
+// NewTLSTestServer starts and returns a TLSTestServer using a handler
+// NOTE: The server's certificate is valid for "localhost", "127.0.0.1" and "::1". When `requireClientCert`
+// is true, requests without a client certificate signed by the CA are rejected. Callers must close the server
+func NewTLSTestServer(handler http.Handler, requireClientCert bool) (*TLSTestServer, error) {
+	// Generate CA
+	ca, err := newTestCertificate(&x509.Certificate{
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		Subject:               pkix.Name{CommonName: "goutils test CA"},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate server and client certificates signed by the CA
+	server, err := newTestCertificate(&x509.Certificate{
+		DNSNames:    []string{"localhost"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		Subject:     pkix.Name{CommonName: "localhost"},
+	}, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newTestCertificate(&x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		Subject:     pkix.Name{CommonName: "goutils test client"},
+	}, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	serverCert, err := tls.X509KeyPair(server.certPEM, server.keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start server
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	s := httptest.NewUnstartedServer(handler)
+	s.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    pool,
+	}
+
+	if requireClientCert {
+		s.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	s.StartTLS()
+
+	return &TLSTestServer{
+		Server:        s,
+		CAPEM:         ca.certPEM,
+		ClientCertPEM: client.certPEM,
+		ClientKeyPEM:  client.keyPEM,
+	}, nil
+}
+
+// TLSConfig returns TLS settings that trust the server's CA and present the client certificate
+func (s *TLSTestServer) TLSConfig() *goutils.TLSConfig {
+	c := goutils.NewTLSConfig()
+	c.CAPEM = s.CAPEM
+	c.CertPEM = s.ClientCertPEM
+	c.KeyPEM = s.ClientKeyPEM
+
+	return c
+}
+
+// newTestCertificate generates a certificate from a template, signed by a parent certificate
+// (or self-signed, if the parent is nil), valid for a day
+func newTestCertificate(template *x509.Certificate, parent *testCertificate) (*testCertificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	template.NotAfter = time.Now().Add(24 * time.Hour)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.SerialNumber = serial
+
+	// Sign the certificate
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &testCertificate{
+		cert:    cert,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		key:     key,
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Tests the tls_server.go file
+package goutilstest
+
+import (
+	// Standard lib
+	"fmt"
+	"net/http"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	// Local
+	goutils "github.com/marksost/go-utils"
+)
+
+var _ = Describe("tls_server.go", func() {
+	var (
+		// Server to test against
+		server *TLSTestServer
+	)
+
+	BeforeEach(func() {
+		// Set server requiring client certificates
+		var err error
+		server, err = NewTLSTestServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, r.TLS.PeerCertificates[0].Subject.CommonName)
+		}), true)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("`NewTLSTestServer` method", func() {
+		It("Accepts requests with the client certificate", func() {
+			// Call method
+			res, err := goutils.DoRequest(&goutils.RequestConfig{Method: "GET", TLS: server.TLSConfig(), URL: server.URL})
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Verify the client certificate was presented
+			body, err := res.String()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(body).To(Equal("goutils test client"))
+		})
+
+		It("Accepts requests to localhost using the server name", func() {
+			// Create config
+			c := server.TLSConfig()
+			c.ServerName = "localhost"
+
+			// Call method
+			code, err := goutils.GetStatusCodeForRequest(&goutils.RequestConfig{Method: "GET", TLS: c, URL: server.URL})
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+		})
+
+		It("Rejects requests with a mismatched server name", func() {
+			// Create config
+			c := server.TLSConfig()
+			c.ServerName = "example.com"
+
+			// Call method
+			_, err := goutils.GetStatusCodeForRequest(&goutils.RequestConfig{Method: "GET", TLS: c, URL: server.URL})
+
+			// Verify return value
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Rejects requests without the client certificate", func() {
+			// Call method
+			_, err := goutils.GetStatusCodeForRequest(&goutils.RequestConfig{
+				Method: "GET",
+				TLS:    &goutils.TLSConfig{CAPEM: server.CAPEM},
+				URL:    server.URL,
+			})
+
+			// Verify return value
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Rejects requests that don't trust the CA, unless verification is skipped", func() {
+			// Call method without the CA
+			_, err := goutils.GetStatusCodeForRequest(&goutils.RequestConfig{
+				Method: "GET",
+				TLS:    &goutils.TLSConfig{CertPEM: server.ClientCertPEM, KeyPEM: server.ClientKeyPEM},
+				URL:    server.URL,
+			})
+
+			// Verify return value
+			Expect(err).To(HaveOccurred())
+
+			// Call method skipping verification
+			code, err := goutils.GetStatusCodeForRequest(&goutils.RequestConfig{
+				Method: "GET",
+				TLS:    &goutils.TLSConfig{CertPEM: server.ClientCertPEM, InsecureSkipVerify: true, KeyPEM: server.ClientKeyPEM},
+				URL:    server.URL,
+			})
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+		})
+
+		It("Returns an error when the TLS settings are invalid", func() {
+			// Call method
+			_, err := goutils.GetStatusCodeForRequest(&goutils.RequestConfig{
+				Method: "GET",
+				TLS:    &goutils.TLSConfig{CAPEM: []byte("invalid")},
+				URL:    server.URL,
+			})
+
+			// Verify return value
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type (
+	// HTTPError represents a response with a non-2xx status code
+	HTTPError struct {
+		Body       []byte      // The start of the response's body, up to `MaxErrorBodyBytes`
+		Header     http.Header // The response's headers
+		Status     string      // The response's status (ex: "404 Not Found")
+		StatusCode int         // The response's status code
+	}
+)
+
+var (
+	// ErrResponseTooLarge is returned when a 2xx response's body is larger than `MaxResponseBytes`
+	ErrResponseTooLarge = errors.New("Response body exceeds the maximum allowed size")
+
+	// MaxErrorBodyBytes is the maximum number of bytes of a response's body kept in an HTTPError
+	MaxErrorBodyBytes = 4 << 10
+
+	// MaxResponseBytes is the maximum number of bytes read from a 2xx response's body by `DoJSON`
+	MaxResponseBytes int64 = 10 << 20
+)
+
+// DoJSON makes an HTTP request with a value encoded as JSON as it's body (if non-nil),
+// and decodes a 2xx response's JSON body into another value (if non-nil)
+// NOTE: Non-2xx responses return an `*HTTPError`, and 2xx bodies larger than `MaxResponseBytes` return
+// `ErrResponseTooLarge`. Request bodies are always sent as "application/json", unless the config's `Headers`
+// set a content type. The config passed in isn't modified. Uses the config's `Context`, if set
+func DoJSON(c *RequestConfig, in, out interface{}) error {
+	return DoJSONContext(c.context(), c, in, out)
+}
+
+// DoJSONContext is the same as `DoJSON`, but uses a context
+// to control cancellation and deadlines of the request
+func DoJSONContext(ctx context.Context, c *RequestConfig, in, out interface{}) error {
+	// Copy the config so the caller's isn't modified
+	jc := *c
+	jc.Headers = c.Headers.Clone()
+
+	if jc.Headers == nil {
+		jc.Headers = make(http.Header)
+	}
+
+	// Ask for JSON, unless told otherwise
+	if jc.Headers.Get("Accept") == "" {
+		jc.Headers.Set("Accept", "application/json")
+	}
+
+	// Encode the request body
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+
+		jc.Body = bytes.NewReader(b)
+		jc.ContentType = "application/json"
+	}
+
+	res, err := MakeRequestContext(ctx, &jc)
+	if err != nil {
+		return err
+	}
+
+	defer CloseResponse(res)
+
+	// Return errors for non-2xx responses, with the start of their body
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, int64(MaxErrorBodyBytes)))
+
+		return &HTTPError{
+			Body:       body,
+			Header:     res.Header,
+			Status:     res.Status,
+			StatusCode: res.StatusCode,
+		}
+	}
+
+	// Read the response body, up to the maximum size
+	body, err := io.ReadAll(io.LimitReader(res.Body, MaxResponseBytes+1))
+	if err != nil {
+		return err
+	}
+
+	if int64(len(body)) > MaxResponseBytes {
+		return ErrResponseTooLarge
+	}
+
+	// Decode the response body
+	// NOTE: Empty bodies (like those of 204 responses) are left undecoded
+	if out == nil || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// Error implements the `error` interface
+func (e *HTTPError) Error() string {
+	status := e.Status
+	if status == "" {
+		status = Int2String(e.StatusCode)
+	}
+
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("Request failed with status %s", status)
+	}
+
+	return fmt.Sprintf("Request failed with status %s: %s", status, strings.TrimSpace(string(e.Body)))
+}
+
+// JSON decodes the error's body snippet as JSON into a value
+// NOTE: Fails for bodies that were truncated to `MaxErrorBodyBytes`
+func (e *HTTPError) JSON(v interface{}) error {
+	return json.Unmarshal(e.Body, v)
+}
@@ -0,0 +1,206 @@
+// Tests the json.go file
+package goutils
+
+import (
+	// Standard lib
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("json.go", func() {
+	Describe("`DoJSON` method", func() {
+		Context("The server returned a 2xx response", func() {
+			It("Encodes the request body and decodes the response body", func() {
+				// Create config
+				c := &RequestConfig{Method: "POST", URL: getMockServer("echo").URL}
+
+				// Call method
+				out := &EchoResponse{}
+				err := DoJSON(c, map[string]string{"foo": "bar"}, out)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(out.Method).To(Equal("POST"))
+				Expect(out.Body).To(MatchJSON(`{"foo":"bar"}`))
+				Expect(out.Headers.Get("Accept")).To(Equal("application/json"))
+				Expect(out.Headers.Get("Content-Type")).To(Equal("application/json"))
+
+				// Verify config wasn't modified
+				Expect(c.Body).To(BeNil())
+				Expect(c.Headers).To(BeNil())
+			})
+
+			It("Sends JSON bodies as JSON, regardless of the config's content type", func() {
+				// Create config
+				c := &RequestConfig{ContentType: "text/plain", Method: "POST", URL: getMockServer("echo").URL}
+
+				// Call method
+				out := &EchoResponse{}
+				err := DoJSON(c, []int{1}, out)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(out.Headers.Get("Content-Type")).To(Equal("application/json"))
+			})
+
+			It("Keeps Accept and Content-Type values set by headers", func() {
+				// Create config
+				c := &RequestConfig{
+					Headers: http.Header{"Accept": {"text/plain"}, "Content-Type": {"application/vnd.api+json"}},
+					Method:  "PUT",
+					URL:     getMockServer("echo").URL,
+				}
+
+				// Call method
+				out := &EchoResponse{}
+				err := DoJSON(c, []int{1}, out)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(out.Headers.Get("Accept")).To(Equal("text/plain"))
+				Expect(out.Headers.Get("Content-Type")).To(Equal("application/vnd.api+json"))
+			})
+
+			It("Skips encoding and decoding for nil values", func() {
+				// Call method
+				err := DoJSON(&RequestConfig{Method: "GET", URL: getMockServer("echo").URL}, nil, nil)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+			})
+
+			It("Skips decoding for empty bodies", func() {
+				// Create client returning an empty body
+				client := &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{Body: newTrackingBody(0), StatusCode: 204}, nil
+					}),
+				}
+
+				// Call method
+				out := map[string]interface{}{}
+				err := DoJSON(&RequestConfig{Client: client, Method: "DELETE", URL: "http://example.com"}, nil, &out)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(out).To(BeEmpty())
+			})
+		})
+
+		Context("The server returned a non-2xx response", func() {
+			It("Returns an HTTP error", func() {
+				// Call method
+				err := DoJSON(&RequestConfig{Method: "GET", URL: getMockServer("bad-request").URL}, nil, &EchoResponse{})
+
+				// Verify return value
+				httpErr := &HTTPError{}
+				Expect(errors.As(err, &httpErr)).To(BeTrue())
+				Expect(httpErr.StatusCode).To(Equal(400))
+				Expect(httpErr.Status).To(Equal("400 Bad Request"))
+				Expect(httpErr.Header).To(Not(BeNil()))
+				Expect(httpErr.Error()).To(Equal(`Request failed with status 400 Bad Request: {"code":400}`))
+
+				// Verify body can be decoded
+				v := map[string]int{}
+				Expect(httpErr.JSON(&v)).To(Succeed())
+				Expect(v).To(Equal(map[string]int{"code": 400}))
+			})
+
+			It("Returns an HTTP error for bodies larger than the maximum response size", func() {
+				// Lower maximum size
+				original := MaxResponseBytes
+				MaxResponseBytes = 5
+				defer func() { MaxResponseBytes = original }()
+
+				// Create client returning a large error body
+				client := &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{Body: newTrackingBody(100), StatusCode: 500}, nil
+					}),
+				}
+
+				// Call method
+				err := DoJSON(&RequestConfig{Client: client, Method: "GET", URL: "http://example.com"}, nil, nil)
+
+				// Verify return value
+				httpErr := &HTTPError{}
+				Expect(errors.As(err, &httpErr)).To(BeTrue())
+				Expect(httpErr.StatusCode).To(Equal(500))
+				Expect(httpErr.Body).To(HaveLen(100))
+			})
+
+			It("Truncates large bodies", func() {
+				// Create client returning a large error body
+				client := &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{Body: newTrackingBody(MaxErrorBodyBytes * 2), StatusCode: 500}, nil
+					}),
+				}
+
+				// Call method
+				err := DoJSON(&RequestConfig{Client: client, Method: "GET", URL: "http://example.com"}, nil, nil)
+
+				// Verify return value
+				httpErr := &HTTPError{}
+				Expect(errors.As(err, &httpErr)).To(BeTrue())
+				Expect(httpErr.Body).To(HaveLen(MaxErrorBodyBytes))
+			})
+		})
+
+		Context("An error occurred", func() {
+			It("Returns an error when the request body can't be encoded", func() {
+				// Call method
+				err := DoJSON(&RequestConfig{Method: "POST", URL: getMockServer("echo").URL}, make(chan int), nil)
+
+				// Verify return value
+				Expect(err).To(BeAssignableToTypeOf(&json.UnsupportedTypeError{}))
+			})
+
+			It("Returns an error when the request fails", func() {
+				Expect(DoJSON(&RequestConfig{Method: "GET", URL: ":"}, nil, nil)).To(Not(Succeed()))
+			})
+
+			It("Returns an error when the response body can't be decoded", func() {
+				// Call method
+				out := []int{}
+				err := DoJSON(&RequestConfig{Method: "GET", URL: getMockServer("echo").URL}, nil, &out)
+
+				// Verify return value
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("Returns an error when the response body is too large", func() {
+				// Lower maximum size
+				original := MaxResponseBytes
+				MaxResponseBytes = 5
+				defer func() { MaxResponseBytes = original }()
+
+				// Create client returning a large body
+				body := newTrackingBody(100)
+				client := &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{Body: body, StatusCode: 200}, nil
+					}),
+				}
+
+				// Call method
+				err := DoJSON(&RequestConfig{Client: client, Method: "GET", URL: "http://example.com"}, nil, nil)
+
+				// Verify return value and body was closed
+				Expect(err).To(Equal(ErrResponseTooLarge))
+				Expect(body.Closed).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("`HTTPError` type", func() {
+		It("Formats errors without a status or body", func() {
+			Expect((&HTTPError{StatusCode: 503}).Error()).To(Equal("Request failed with status 503"))
+		})
+	})
+})
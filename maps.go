@@ -0,0 +1,158 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// EqualMaps returns true if two maps contain the same keys mapped to the same values
+func EqualMaps[K, V comparable](a, b map[K]V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, va := range a {
+		if vb, ok := b[k]; !ok || va != vb {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterMap returns a new map containing the entries of a map that satisfy a function
+func FilterMap[K comparable, V any](m map[K]V, fn func(K, V) bool) map[K]V {
+	// Form return value
+	ret := make(map[K]V)
+
+	for k, v := range m {
+		if fn(k, v) {
+			ret[k] = v
+		}
+	}
+
+	return ret
+}
+
+// Invert returns a new map with the keys and values of a map swapped
+// NOTE: When multiple keys share a value, which one is kept is undefined
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	// Form return value
+	ret := make(map[V]K, len(m))
+
+	for k, v := range m {
+		ret[v] = k
+	}
+
+	return ret
+}
+
+// Keys returns the keys of a map, in no particular order
+// NOTE: See `SortedKeys` for a deterministic order
+func Keys[K comparable, V any](m map[K]V) []K {
+	// Form return value
+	ret := make([]K, 0, len(m))
+
+	for k := range m {
+		ret = append(ret, k)
+	}
+
+	return ret
+}
+
+// MapKeys returns a new map with a function applied to each key of a map
+// NOTE: When the function returns the same key more than once, which value is kept is undefined
+func MapKeys[K, L comparable, V any](m map[K]V, fn func(K) L) map[L]V {
+	// Form return value
+	ret := make(map[L]V, len(m))
+
+	for k, v := range m {
+		ret[fn(k)] = v
+	}
+
+	return ret
+}
+
+// MapValues returns a new map with a function applied to each value of a map
+func MapValues[K comparable, V, W any](m map[K]V, fn func(V) W) map[K]W {
+	// Form return value
+	ret := make(map[K]W, len(m))
+
+	for k, v := range m {
+		ret[k] = fn(v)
+	}
+
+	return ret
+}
+
+// OmitKeys returns a new map containing the entries of a map except those with the keys passed in
+func OmitKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omit := membership(keys)
+
+	return FilterMap(m, func(k K, _ V) bool {
+		return !omit(k)
+	})
+}
+
+// PickKeys returns a new map containing only the entries of a map with the keys passed in
+// NOTE: Keys that aren't in the map are ignored
+func PickKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	// Form return value
+	ret := make(map[K]V, len(keys))
+
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			ret[k] = v
+		}
+	}
+
+	return ret
+}
+
+// SortedEntries returns an iterator over the entries of a map, ordered by key
+// NOTE: Useful for producing stable output (like logs or signatures) from maps
+func SortedEntries[K cmp.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range SortedKeys(m) {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}
+}
+
+// SortedKeys returns the keys of a map in sorted order
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	ret := Keys(m)
+	slices.Sort(ret)
+
+	return ret
+}
+
+// SortedValues returns the values of a map, ordered by their keys
+func SortedValues[K cmp.Ordered, V any](m map[K]V) []V {
+	// Form return value
+	ret := make([]V, 0, len(m))
+
+	for _, k := range SortedKeys(m) {
+		ret = append(ret, m[k])
+	}
+
+	return ret
+}
+
+// Values returns the values of a map, in no particular order
+// NOTE: See `SortedValues` for a deterministic order
+func Values[K comparable, V any](m map[K]V) []V {
+	// Form return value
+	ret := make([]V, 0, len(m))
+
+	for _, v := range m {
+		ret = append(ret, v)
+	}
+
+	return ret
+}
@@ -0,0 +1,98 @@
+// Tests the maps.go file
+package goutils
+
+import (
+	// Standard lib
+	"strings"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("maps.go", func() {
+	var (
+		// Map to test against
+		m map[string]int
+	)
+
+	BeforeEach(func() {
+		// Set map
+		m = map[string]int{"c": 3, "a": 1, "b": 2}
+	})
+
+	Describe("`Keys` and `Values` methods", func() {
+		It("Returns the keys and values of a map", func() {
+			Expect(Keys(m)).To(ConsistOf("a", "b", "c"))
+			Expect(Values(m)).To(ConsistOf(1, 2, 3))
+			Expect(Keys(map[string]int{})).To(BeEmpty())
+		})
+	})
+
+	Describe("`SortedKeys` and `SortedValues` methods", func() {
+		It("Returns the keys and values of a map ordered by key", func() {
+			Expect(SortedKeys(m)).To(Equal([]string{"a", "b", "c"}))
+			Expect(SortedValues(m)).To(Equal([]int{1, 2, 3}))
+		})
+	})
+
+	Describe("`SortedEntries` method", func() {
+		It("Iterates over entries ordered by key", func() {
+			// Collect entries
+			actual := []string{}
+			for k, v := range SortedEntries(m) {
+				actual = append(actual, k+"="+Int2String(v))
+			}
+
+			// Verify entries
+			Expect(actual).To(Equal([]string{"a=1", "b=2", "c=3"}))
+		})
+
+		It("Stops early when the consumer does", func() {
+			// Collect first entry only
+			actual := []string{}
+			for k := range SortedEntries(m) {
+				actual = append(actual, k)
+				break
+			}
+
+			// Verify entries
+			Expect(actual).To(Equal([]string{"a"}))
+		})
+	})
+
+	Describe("`Invert` method", func() {
+		It("Swaps keys and values", func() {
+			Expect(Invert(m)).To(Equal(map[int]string{1: "a", 2: "b", 3: "c"}))
+		})
+	})
+
+	Describe("`FilterMap` method", func() {
+		It("Keeps entries satisfying a function", func() {
+			Expect(FilterMap(m, func(k string, v int) bool { return k == "a" || v == 3 })).To(Equal(map[string]int{"a": 1, "c": 3}))
+		})
+	})
+
+	Describe("`MapKeys` and `MapValues` methods", func() {
+		It("Applies a function to each key or value", func() {
+			Expect(MapKeys(m, strings.ToUpper)).To(Equal(map[string]int{"A": 1, "B": 2, "C": 3}))
+			Expect(MapValues(m, Int2String)).To(Equal(map[string]string{"a": "1", "b": "2", "c": "3"}))
+		})
+	})
+
+	Describe("`PickKeys` and `OmitKeys` methods", func() {
+		It("Keeps or drops entries by key", func() {
+			Expect(PickKeys(m, "a", "c", "d")).To(Equal(map[string]int{"a": 1, "c": 3}))
+			Expect(OmitKeys(m, "a", "d")).To(Equal(map[string]int{"b": 2, "c": 3}))
+		})
+	})
+
+	Describe("`EqualMaps` method", func() {
+		It("Compares maps by their entries", func() {
+			Expect(EqualMaps(m, map[string]int{"a": 1, "b": 2, "c": 3})).To(BeTrue())
+			Expect(EqualMaps(m, map[string]int{"a": 1, "b": 2, "c": 4})).To(BeFalse())
+			Expect(EqualMaps(m, map[string]int{"a": 1, "b": 2, "d": 3})).To(BeFalse())
+			Expect(EqualMaps(m, map[string]int{"a": 1})).To(BeFalse())
+		})
+	})
+})
@@ -3,10 +3,13 @@ package goutils
 
 import (
 	// Standard lib
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"net/url"
 	"regexp"
 	"time"
 )
@@ -15,16 +18,29 @@ type (
 	// RequestConfig contains a set of configuration settings
 	// to be used with the methods that make HTTP requests
 	RequestConfig struct {
-		Body        io.Reader    // The body of the request, if any
-		Client      *http.Client // An HTTP client to use, if needed
-		ContentType string       // The content type to send with the request
-		Method      string       // The HTTP method to use
-		Timeout     int          // A timeout, in seconds, for the request
-		URL         string       // The URL to make the request to
+		Body        io.Reader       // The body of the request, if any
+		Breaker     *CircuitBreaker // A circuit breaker consulted before each request to the URL's host, if any
+		Client      *http.Client    // An HTTP client to use, if needed. Defaults to a client from `DefaultClientFactory`
+		ContentType string          // The content type to send with the request
+		Context     context.Context // A context to control cancellation and deadlines of the request, if any
+		Cookies     []*http.Cookie  // Cookies to send with the request, if any
+		Headers     http.Header     // Headers to send with the request, if any. Take precedence over `ContentType`
+		Method      string          // The HTTP method to use
+		Query       url.Values      // Query parameters merged into the URL's existing query string, if any
+		RateLimiter *RateLimiter    // A rate limiter waited on before each request, if any
+		Retry       *RetryPolicy    // A policy used to retry failed requests, if any
+		Timeout     int             // A timeout, in seconds, for the request. Kept for compatibility, and ignored when `Timeouts.Overall` is set
+		Timeouts    *TimeoutConfig  // Sub-second and per-phase timeouts for the request, if any
+		TLS         *TLSConfig      // TLS settings for HTTPS requests, if any. Ignored when `Client` is set
+		URL         string          // The URL to make the request to
 	}
 )
 
 var (
+	// portRegex extracts the port from a listener's address
+	// NOTE: Compiled once to avoid re-compiling on every `GetEmptyPort` call
+	portRegex = regexp.MustCompile("\\d+$")
+
 	// ServerHost is the host to run the Redis server on
 	// NOTE: Public variable to allow package authors the ability
 	// to change this before starting the Redis server
@@ -36,10 +52,19 @@ var (
 func NewRequestConfig() *RequestConfig {
 	return &RequestConfig{
 		Body:        nil,
+		Breaker:     nil,
 		Client:      nil,
 		ContentType: "application/json",
+		Context:     nil,
+		Cookies:     nil,
+		Headers:     nil,
 		Method:      "GET",
+		Query:       nil,
+		RateLimiter: nil,
+		Retry:       nil,
 		Timeout:     5,
+		Timeouts:    nil,
+		TLS:         nil,
 		URL:         "",
 	}
 }
@@ -47,16 +72,13 @@ func NewRequestConfig() *RequestConfig {
 // GetEmptyPort returns a number to be used as a new server's port
 // NOTE: Uses tcp to allow the kernel to give an open port
 func GetEmptyPort() (int, error) {
-	// Create regex for extracting port
-	r, _ := regexp.Compile("\\d+$")
-
 	// NOTE: Uses "port" 0 to allow the kernal to chose a port for itself
 	if l, err := net.Listen("tcp", fmt.Sprintf("%s:0", ServerHost)); err == nil {
 		// Close listener
 		defer l.Close()
 
 		// Use regex to extract port
-		port := r.FindString(l.Addr().String())
+		port := portRegex.FindString(l.Addr().String())
 
 		if len(port) != 0 {
 			return String2Int(port), nil
@@ -69,36 +91,119 @@ func GetEmptyPort() (int, error) {
 // GetStatusCodeForRequest attempts to make an HTTP request against a URL
 // with a given HTTP method (ex: GET) and returns it's status code if successful,
 // an error otherwise
+// NOTE: Uses the config's `Context`, if set
 func GetStatusCodeForRequest(c *RequestConfig) (int, error) {
-	// Make new request
-	req, err := http.NewRequest(c.Method, c.URL, c.Body)
-	if err != nil {
-		return 0, err
-	}
-
-	// Create client
-	// NOTE: Allow a client to be passed in (like during testing)
-	if c.Client == nil {
-		c.Client = &http.Client{
-			Timeout: time.Duration(c.Timeout) * time.Second,
-		}
-	}
+	return GetStatusCodeForRequestContext(c.context(), c)
+}
 
-	// Send request
-	res, err := c.Client.Do(req)
+// GetStatusCodeForRequestContext is the same as `GetStatusCodeForRequest`,
+// but uses a context to control cancellation and deadlines of the request
+// NOTE: The context passed in takes precedence over the config's `Context`
+func GetStatusCodeForRequestContext(ctx context.Context, c *RequestConfig) (int, error) {
+	res, err := MakeRequestContext(ctx, c)
 	if err != nil {
 		return 0, err
 	}
 
+	// Drain and close the body so the connection can be reused
+	defer CloseResponse(res)
+
 	return res.StatusCode, nil
 }
 
 // MakeRequest attempts to make an HTTP request against a URL
 // with a given HTTP methor (ex: GET) and returns the response
 // as well as any errors that may have occurred
+// NOTE: Uses the config's `Context`, if set
 func MakeRequest(c *RequestConfig) (*http.Response, error) {
-	// Make new request
-	req, err := http.NewRequest(c.Method, c.URL, c.Body)
+	return MakeRequestContext(c.context(), c)
+}
+
+// MakeRequestContext is the same as `MakeRequest`, but uses a context
+// to control cancellation and deadlines of the request
+// NOTE: The context passed in takes precedence over the config's `Context`,
+// and it's values are available to the client's transport. Failed requests are retried
+// according to the config's `Retry` policy, if set
+func MakeRequestContext(ctx context.Context, c *RequestConfig) (*http.Response, error) {
+	if c.Retry != nil {
+		return c.Retry.do(ctx, c)
+	}
+
+	return c.do(ctx)
+}
+
+// client returns the config's client, or a shared client from `DefaultClientFactory` if none was set
+// NOTE: Allows a client to be passed in (like during testing) without the config being modified
+func (c *RequestConfig) client() (*http.Client, error) {
+	if c.Client != nil {
+		return c.Client, nil
+	}
+
+	o := ClientOptions{
+		TLS:     c.TLS,
+		Timeout: time.Duration(c.Timeout) * time.Second,
+	}
+
+	if t := c.Timeouts; t != nil {
+		// The overall timeout takes precedence over `Timeout`, and is enforced by the request's context
+		if t.Overall > 0 {
+			o.Timeout = 0
+		}
+
+		o.DialTimeout = t.Dial
+		o.ResponseHeaderTimeout = t.ResponseHeader
+		o.TLSHandshakeTimeout = t.TLSHandshake
+	}
+
+	return DefaultClientFactory.Client(o)
+}
+
+// context returns the config's context, or a background context if none was set
+func (c *RequestConfig) context() context.Context {
+	if c.Context == nil {
+		return context.Background()
+	}
+
+	return c.Context
+}
+
+// do makes a single attempt at the config's HTTP request
+// NOTE: Bounds the whole attempt (including reading the response's body) and the time spent
+// reading the response's body by the config's `Timeouts`, if set, for any client
+func (c *RequestConfig) do(ctx context.Context) (*http.Response, error) {
+	t := c.Timeouts
+	if t == nil || (t.Overall <= 0 && t.BodyRead <= 0) {
+		return c.send(ctx)
+	}
+
+	// Allow the timeouts to cancel the request
+	var cancel context.CancelFunc
+	if t.Overall > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.Overall)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	res, err := c.send(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// Release the context once the body is closed
+	res.Body = newTimeoutBody(res.Body, t.BodyRead, cancel)
+
+	return res, nil
+}
+
+// newRequest creates an HTTP request from the config's settings
+func (c *RequestConfig) newRequest(ctx context.Context) (*http.Request, error) {
+	u, err := c.requestURL()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, c.Method, u, c.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -108,14 +213,98 @@ func MakeRequest(c *RequestConfig) (*http.Response, error) {
 		req.Header.Add("Content-Type", c.ContentType)
 	}
 
-	// Create client
-	// NOTE: Allow a client to be passed in (like during testing)
-	if c.Client == nil {
-		c.Client = &http.Client{
-			Timeout: time.Duration(c.Timeout) * time.Second,
+	// Add headers, replacing any set above
+	for k, values := range c.Headers {
+		req.Header.Del(k)
+
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
+
+	// Add cookies
+	for _, cookie := range c.Cookies {
+		req.AddCookie(cookie)
+	}
+
+	return req, nil
+}
+
+// requestURL returns the config's URL with it's query parameters merged in
+func (c *RequestConfig) requestURL() (string, error) {
+	if len(c.Query) == 0 {
+		return c.URL, nil
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return "", err
+	}
+
+	// Merge query parameters into any existing ones
+	q := u.Query()
+	for k, values := range c.Query {
+		for _, v := range values {
+			q.Add(k, v)
+		}
+	}
+
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// send sends the config's HTTP request
+// NOTE: Waits on the config's `RateLimiter` and consults the config's `Breaker`, if set.
+// Timeout errors are returned as `*TimeoutError`s describing the phase that timed out
+func (c *RequestConfig) send(ctx context.Context) (*http.Response, error) {
+	// Track the request's phases to describe timeouts
+	tracker, ctx := newPhaseTracker(ctx)
+
+	// Make new request
+	req, err := c.newRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for the rate limiter
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(ctx, c.RateLimiter.Key(req)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Check the host's circuit before sending the request
+	host := req.URL.Host
+	if c.Breaker != nil {
+		if err := c.Breaker.Allow(host); err != nil {
+			return nil, err
+		}
+	}
+
+	// Send request
+	res, err := client.Do(req)
+	err = tracker.wrap(err)
+
+	// Adapt the rate limiter to the response
+	if c.RateLimiter != nil && c.RateLimiter.config.Adaptive && err == nil {
+		c.RateLimiter.Update(c.RateLimiter.Key(req), res)
+	}
+
+	// Record the outcome, ignoring requests cancelled by the caller
+	// NOTE: Requests that passed the caller's deadline count as failures
+	if c.Breaker != nil {
+		if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+			c.Breaker.release(host)
+		} else {
+			c.Breaker.Record(host, !c.Breaker.isFailure(res, err))
 		}
 	}
 
-	// Send request and return the response
-	return c.Client.Do(req)
+	return res, err
 }
@@ -4,6 +4,8 @@ package goutils
 import (
 	// Standard lib
 	"bytes"
+	"context"
+	"errors"
 	"net/http"
 	"net/url"
 	"time"
@@ -21,9 +23,18 @@ var _ = Describe("net.go", func() {
 
 			// Verify request config was properly created and returned
 			Expect(c.Body).To(BeNil())
+			Expect(c.Breaker).To(BeNil())
 			Expect(c.Client).To(BeNil())
+			Expect(c.Context).To(BeNil())
+			Expect(c.Cookies).To(BeNil())
+			Expect(c.Headers).To(BeNil())
 			Expect(c.Method).To(Equal("GET"))
+			Expect(c.Query).To(BeNil())
+			Expect(c.RateLimiter).To(BeNil())
+			Expect(c.Retry).To(BeNil())
 			Expect(c.Timeout).To(Equal(5))
+			Expect(c.Timeouts).To(BeNil())
+			Expect(c.TLS).To(BeNil())
 			Expect(c.URL).To(Equal(""))
 		})
 	})
@@ -160,6 +171,40 @@ var _ = Describe("net.go", func() {
 		})
 	})
 
+	Describe("`GetStatusCodeForRequestContext` method", func() {
+		Context("The context's deadline passes before the server responds", func() {
+			It("Returns a deadline exceeded error", func() {
+				// Create context with a short deadline
+				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+				defer cancel()
+
+				// Call method
+				_, err := GetStatusCodeForRequestContext(ctx, &RequestConfig{
+					Method:  "GET",
+					Timeout: 5,
+					URL:     getMockServer("timeout").URL,
+				})
+
+				// Verify return value
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			})
+		})
+
+		Context("The server returned a valid response", func() {
+			It("Returns the status code", func() {
+				// Call method
+				code, err := GetStatusCodeForRequestContext(context.Background(), &RequestConfig{
+					Method: "GET",
+					URL:    getMockServer("bad-request").URL,
+				})
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(code).To(Equal(400))
+			})
+		})
+	})
+
 	Describe("`MakeRequest` method", func() {
 		var (
 			// Input for `MakeRequest` input
@@ -264,4 +309,90 @@ var _ = Describe("net.go", func() {
 			})
 		})
 	})
+
+	Describe("`MakeRequestContext` method", func() {
+		Context("The context is cancelled before the server responds", func() {
+			It("Returns a cancellation error", func() {
+				// Create context and cancel it shortly after the request starts
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(100*time.Millisecond, cancel)
+
+				// Call method
+				_, err := MakeRequestContext(ctx, &RequestConfig{
+					Method:  "GET",
+					Timeout: 5,
+					URL:     getMockServer("timeout").URL,
+				})
+
+				// Verify return value
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+			})
+		})
+
+		Context("The config has a context set", func() {
+			It("Is overridden by the context passed in", func() {
+				// Create cancelled context for the config
+				cancelled, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				// Call method
+				res, err := MakeRequestContext(context.Background(), &RequestConfig{
+					Context: cancelled,
+					Method:  "GET",
+					URL:     getMockServer("default").URL,
+				})
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(res.StatusCode).To(Equal(200))
+			})
+		})
+
+		Context("The context carries values", func() {
+			It("Makes them available to the client's transport", func() {
+				// Create context with a value
+				type key struct{}
+				ctx := context.WithValue(context.Background(), key{}, "foo")
+
+				// Create client that records the context value
+				var actual interface{}
+				client := &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						actual = req.Context().Value(key{})
+						return http.DefaultTransport.RoundTrip(req)
+					}),
+				}
+
+				// Call method
+				_, err := MakeRequestContext(ctx, &RequestConfig{
+					Client: client,
+					Method: "GET",
+					URL:    getMockServer("default").URL,
+				})
+
+				// Verify context value was propagated
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal("foo"))
+			})
+		})
+	})
+
+	Describe("`MakeRequest` method with a config context", func() {
+		It("Uses the config's context", func() {
+			// Create context with a short deadline
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			// Call method
+			_, err := MakeRequest(&RequestConfig{
+				Context: ctx,
+				Method:  "GET",
+				Timeout: 5,
+				URL:     getMockServer("timeout").URL,
+			})
+
+			// Verify return value
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
 })
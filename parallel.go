@@ -0,0 +1,219 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+type (
+	// ParallelConfig contains a set of configuration settings
+	// to be used with the methods that process slices in parallel
+	ParallelConfig struct {
+		CollectErrors bool                  // Whether to keep going after an error and return every error, instead of stopping at the first
+		Progress      func(done, total int) // Called after each item is processed, if set. Calls are never concurrent
+		Workers       int                   // The maximum number of items to process at once
+	}
+
+	// ParallelError represents an error that occurred while processing a single item in parallel
+	ParallelError struct {
+		Err   error // The error returned while processing the item
+		Index int   // The index of the item within the input slice
+	}
+)
+
+// NewParallelConfig returns a ParallelConfig struct with
+// default settings set for each of it's properties
+func NewParallelConfig() *ParallelConfig {
+	return &ParallelConfig{
+		CollectErrors: false,
+		Progress:      nil,
+		Workers:       runtime.GOMAXPROCS(0),
+	}
+}
+
+// Error implements the `error` interface
+func (e *ParallelError) Error() string {
+	return fmt.Sprintf("Error processing item at index %d: %s", e.Index, e.Err.Error())
+}
+
+// Unwrap returns the underlying error, for use with `errors.Is` and `errors.As`
+func (e *ParallelError) Unwrap() error {
+	return e.Err
+}
+
+// MakeRequests makes an HTTP request for each request config using at most `workers` requests at once,
+// returning the responses in the same order as the configs
+// NOTE: Every request is attempted (unless the context is cancelled), so the responses of successful
+// requests are always returned and must be closed by the caller. Failed requests have a nil response,
+// and their errors are joined into the returned error as `*ParallelError`s. The context passed in
+// takes precedence over each config's `Context`
+func MakeRequests(ctx context.Context, configs []*RequestConfig, workers int) ([]*http.Response, error) {
+	c := NewParallelConfig()
+	c.CollectErrors = true
+	c.Workers = workers
+
+	return ParallelMapConfig(ctx, configs, c, MakeRequestContext)
+}
+
+// ParallelMap applies a function to each value of a slice using at most `workers` goroutines,
+// returning the results in the same order as the input
+// NOTE: Stops at the first error, cancelling the context passed to in-flight calls and returning
+// the error as a `*ParallelError` alongside the results of calls that succeeded, so that results
+// holding resources (like `*http.Response`s) can still be released. See `MakeRequests` for making
+// requests in parallel, and `ParallelMapConfig` for more options
+func ParallelMap[T, U any](ctx context.Context, items []T, workers int, fn func(context.Context, T) (U, error)) ([]U, error) {
+	c := NewParallelConfig()
+	c.Workers = workers
+
+	return ParallelMapConfig(ctx, items, c, fn)
+}
+
+// ParallelMapConfig applies a function to each value of a slice in parallel using the settings of a config,
+// returning the results in the same order as the input
+// NOTE: The results of every successful call are always returned, with zero values for failed and skipped calls.
+// When collecting errors, they're returned alongside the errors of failed calls (joined, in input order,
+// as `*ParallelError`s), otherwise alongside the first error. If the context is cancelled, items that
+// haven't started are skipped and the context's error is returned. The context passed to `fn` is only
+// cancelled when stopping at the first error, so successful results can outlive the call.
+// A nil config uses the defaults from `NewParallelConfig`
+func ParallelMapConfig[T, U any](ctx context.Context, items []T, c *ParallelConfig, fn func(context.Context, T) (U, error)) ([]U, error) {
+	if c == nil {
+		c = NewParallelConfig()
+	}
+
+	var (
+		// Guards all values below
+		mu sync.Mutex
+		// The number of items processed so far
+		done int
+		// Errors returned by failed calls
+		errs []*ParallelError
+		// Functions to cancel the context of each in-flight call, by index
+		inFlight = make(map[int]context.CancelFunc)
+		// The results of successful calls
+		results = make([]U, len(items))
+		// Closed to stop processing after the first error
+		stop = make(chan struct{})
+		// Whether `stop` has been closed
+		stopped bool
+	)
+
+	// Start workers
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for w := 0; w < min(max(c.Workers, 1), len(items)); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				mu.Lock()
+
+				// Skip items once stopped
+				if stopped || ctx.Err() != nil {
+					mu.Unlock()
+					continue
+				}
+
+				// Give each call it's own context, so in-flight calls can be cancelled after the first error
+				// NOTE: Contexts of successful calls are never cancelled, as their results
+				// (like `*http.Response`s) may still depend on them
+				callCtx := ctx
+				if !c.CollectErrors {
+					var cancel context.CancelFunc
+					callCtx, cancel = context.WithCancel(ctx)
+					inFlight[i] = cancel
+				}
+
+				mu.Unlock()
+
+				u, err := fn(callCtx, items[i])
+
+				mu.Lock()
+
+				cancel := inFlight[i]
+				delete(inFlight, i)
+
+				if err != nil {
+					errs = append(errs, &ParallelError{Err: err, Index: i})
+
+					// Release the failed call's context
+					if cancel != nil {
+						cancel()
+					}
+
+					if !c.CollectErrors && !stopped {
+						stopped = true
+						close(stop)
+
+						for _, cancel := range inFlight {
+							cancel()
+						}
+					}
+				} else {
+					results[i] = u
+				}
+
+				done++
+				if c.Progress != nil {
+					c.Progress(done, len(items))
+				}
+
+				mu.Unlock()
+			}
+		}()
+	}
+
+	// Feed items to workers until done or stopped
+	for i := range items {
+		select {
+		case jobs <- i:
+			continue
+		case <-ctx.Done():
+		case <-stop:
+		}
+
+		break
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if !c.CollectErrors {
+		// Return the first error
+		if len(errs) > 0 {
+			return results, errs[0]
+		}
+
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		return results, nil
+	}
+
+	// Join errors in input order
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Index < errs[j].Index
+	})
+
+	joined := make([]error, 0, len(errs)+1)
+	for _, err := range errs {
+		joined = append(joined, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		joined = append(joined, err)
+	}
+
+	return results, errors.Join(joined...)
+}
@@ -0,0 +1,249 @@
+// Tests the parallel.go file
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("parallel.go", func() {
+	var (
+		// Function to parse a string as an int, after a short delay
+		parse = func(ctx context.Context, s string) (int, error) {
+			time.Sleep(time.Millisecond)
+			return strconv.Atoi(s)
+		}
+	)
+
+	Describe("`NewParallelConfig` method", func() {
+		It("Returns a valid parallel config struct", func() {
+			// Call method
+			c := NewParallelConfig()
+
+			// Verify parallel config was properly created and returned
+			Expect(c.CollectErrors).To(BeFalse())
+			Expect(c.Progress).To(BeNil())
+			Expect(c.Workers).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("`ParallelMap` method", func() {
+		Context("When every call succeeds", func() {
+			It("Returns the results in input order", func() {
+				// Create input
+				input := make([]string, 100)
+				for i := range input {
+					input[i] = Int2String(i)
+				}
+
+				// Call method
+				actual, err := ParallelMap(context.Background(), input, 8, parse)
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(HaveLen(100))
+				for i, v := range actual {
+					Expect(v).To(Equal(i))
+				}
+			})
+		})
+
+		Context("When a call fails", func() {
+			It("Stops and returns the first error with the successful results", func() {
+				// Count calls
+				var calls int32
+
+				// Call method
+				actual, err := ParallelMap(context.Background(), []string{"1", "foo", "3", "4", "5", "6"}, 1,
+					func(ctx context.Context, s string) (int, error) {
+						atomic.AddInt32(&calls, 1)
+						return parse(ctx, s)
+					})
+
+				// Verify return values
+				Expect(actual).To(Equal([]int{1, 0, 0, 0, 0, 0}))
+				Expect(err).To(MatchError(ContainSubstring("index 1")))
+				Expect(errors.Is(err, strconv.ErrSyntax)).To(BeTrue())
+				Expect(atomic.LoadInt32(&calls)).To(BeNumerically("<=", 3))
+			})
+		})
+
+		Context("When a request fails", func() {
+			It("Returns the responses of successful requests so they can be closed", func() {
+				// Create test server to mock responses
+				server := getMockServer("default")
+				defer server.Close()
+
+				// Call method
+				responses, err := ParallelMap(context.Background(), []*RequestConfig{
+					&RequestConfig{Method: "GET", URL: server.URL},
+					&RequestConfig{Method: "GET", URL: ":"},
+				}, 1, MakeRequestContext)
+
+				// Verify return values
+				Expect(err).To(MatchError(ContainSubstring("index 1")))
+				Expect(responses).To(HaveLen(2))
+				Expect(responses[0].StatusCode).To(Equal(200))
+				Expect(responses[1]).To(BeNil())
+
+				// Close response bodies
+				responses[0].Body.Close()
+			})
+		})
+
+		Context("When returning responses", func() {
+			It("Leaves their bodies readable", func() {
+				// Create test server to mock responses
+				server := getMockServer("stream")
+				defer server.Close()
+
+				// Call method
+				responses, err := ParallelMap(context.Background(), []*RequestConfig{
+					&RequestConfig{Method: "GET", URL: server.URL},
+				}, 1, MakeRequestContext)
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				defer responses[0].Body.Close()
+
+				body, err := io.ReadAll(responses[0].Body)
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(string(body)).To(Equal(strings.Repeat("chunk", 5)))
+			})
+		})
+
+		Context("When the context is cancelled", func() {
+			It("Skips remaining items and returns the context's error", func() {
+				// Create cancelled context
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				// Call method
+				actual, err := ParallelMap(ctx, []string{"1", "2"}, 2, parse)
+
+				// Verify return values
+				Expect(actual).To(Equal([]int{0, 0}))
+				Expect(err).To(MatchError(context.Canceled))
+			})
+		})
+	})
+
+	Describe("`ParallelMapConfig` method", func() {
+		Context("When collecting errors", func() {
+			It("Returns every result and error", func() {
+				// Create config
+				c := NewParallelConfig()
+				c.CollectErrors = true
+				c.Workers = 3
+
+				// Call method
+				actual, err := ParallelMapConfig(context.Background(), []string{"1", "foo", "3", "bar"}, c, parse)
+
+				// Verify return values
+				Expect(actual).To(Equal([]int{1, 0, 3, 0}))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Error processing item at index 1: strconv.Atoi: parsing \"foo\": invalid syntax\n" +
+					"Error processing item at index 3: strconv.Atoi: parsing \"bar\": invalid syntax"))
+
+				// Verify errors can be inspected
+				var pe *ParallelError
+				Expect(errors.As(err, &pe)).To(BeTrue())
+				Expect(pe.Index).To(Equal(1))
+			})
+		})
+
+		Context("When reporting progress", func() {
+			It("Calls the progress function after each item", func() {
+				// Create config
+				progress := []int{}
+				c := &ParallelConfig{
+					Progress: func(done, total int) {
+						Expect(total).To(Equal(5))
+						progress = append(progress, done)
+					},
+					Workers: 0, // NOTE: Ensures at least one worker is used
+				}
+
+				// Call method
+				_, err := ParallelMapConfig(context.Background(), []string{"1", "2", "3", "4", "5"}, c, parse)
+
+				// Verify progress was reported
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(progress).To(Equal([]int{1, 2, 3, 4, 5}))
+			})
+		})
+
+		Context("When no config is passed", func() {
+			It("Uses the default config", func() {
+				// Call method
+				actual, err := ParallelMapConfig(context.Background(), []string{"1"}, nil, parse)
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal([]int{1}))
+			})
+		})
+	})
+
+	Describe("`MakeRequests` method", func() {
+		It("Makes requests in parallel, returning responses and errors in order", func() {
+			// Create test server to mock responses
+			server := getMockServer("default")
+			defer server.Close()
+
+			// Create request configs
+			configs := []*RequestConfig{
+				&RequestConfig{Method: "GET", URL: server.URL},
+				&RequestConfig{Method: "GET", URL: ":"},
+				&RequestConfig{Method: "GET", URL: server.URL},
+			}
+
+			// Call method
+			responses, err := MakeRequests(context.Background(), configs, 2)
+
+			// Verify return values
+			Expect(err).To(MatchError(ContainSubstring("index 1")))
+			Expect(responses).To(HaveLen(3))
+			Expect(responses[0].StatusCode).To(Equal(200))
+			Expect(responses[1]).To(BeNil())
+			Expect(responses[2].StatusCode).To(Equal(200))
+
+			// Close response bodies
+			responses[0].Body.Close()
+			responses[2].Body.Close()
+		})
+
+		It("Returns responses with readable bodies", func() {
+			// Create test server to mock responses
+			server := getMockServer("stream")
+			defer server.Close()
+
+			// Call method
+			responses, err := MakeRequests(context.Background(), []*RequestConfig{
+				&RequestConfig{Method: "GET", URL: server.URL},
+				&RequestConfig{Method: "GET", URL: server.URL},
+			}, 2)
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+
+			for _, res := range responses {
+				body, err := io.ReadAll(res.Body)
+				res.Body.Close()
+
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(string(body)).To(Equal(strings.Repeat("chunk", 5)))
+			}
+		})
+	})
+})
@@ -0,0 +1,106 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"strconv"
+
+	// Third-party
+	log "github.com/sirupsen/logrus"
+)
+
+// Ptr returns a pointer to a copy of the value passed in
+// NOTE: Useful for populating pointer fields from literals or constants
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns the value a pointer points to, or a default value if the pointer is nil
+func Deref[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+
+	return *p
+}
+
+// BoolPtr2StringPtr converts a *bool to a *string, returning nil for a nil pointer
+func BoolPtr2StringPtr(p *bool) *string {
+	return convertPtr(p, Bool2String)
+}
+
+// Float64Ptr2StringPtr converts a *float64 to a *string, returning nil for a nil pointer
+func Float64Ptr2StringPtr(p *float64) *string {
+	return convertPtr(p, Float642String)
+}
+
+// IntPtr2StringPtr converts an *int to a *string, returning nil for a nil pointer
+func IntPtr2StringPtr(p *int) *string {
+	return convertPtr(p, Int2String)
+}
+
+// Int64Ptr2StringPtr converts an *int64 to a *string, returning nil for a nil pointer
+func Int64Ptr2StringPtr(p *int64) *string {
+	return convertPtr(p, Int642String)
+}
+
+// StringPtr2BoolPtr converts a *string to a *bool, returning nil for a nil pointer
+// or a string that can't be converted
+func StringPtr2BoolPtr(p *string) *bool {
+	return parsePtr(p, "bool", strconv.ParseBool)
+}
+
+// StringPtr2Float64Ptr converts a *string to a *float64, returning nil for a nil pointer
+// or a string that can't be converted
+func StringPtr2Float64Ptr(p *string) *float64 {
+	return parsePtr(p, "float64", func(v string) (float64, error) {
+		return strconv.ParseFloat(v, 64)
+	})
+}
+
+// StringPtr2IntPtr converts a *string to an *int, returning nil for a nil pointer
+// or a string that can't be converted
+func StringPtr2IntPtr(p *string) *int {
+	return parsePtr(p, "int", strconv.Atoi)
+}
+
+// StringPtr2Int64Ptr converts a *string to an *int64, returning nil for a nil pointer
+// or a string that can't be converted
+func StringPtr2Int64Ptr(p *string) *int64 {
+	return parsePtr(p, "int64", func(v string) (int64, error) {
+		return strconv.ParseInt(v, 10, 64)
+	})
+}
+
+// convertPtr applies a conversion function to the value a pointer points to,
+// propagating nil pointers
+func convertPtr[T, U any](p *T, fn func(T) U) *U {
+	if p == nil {
+		return nil
+	}
+
+	v := fn(*p)
+
+	return &v
+}
+
+// parsePtr applies a parsing function to the string a pointer points to,
+// propagating nil pointers and returning nil if parsing fails
+func parsePtr[T any](p *string, typ string, fn func(string) (T, error)) *T {
+	if p == nil {
+		return nil
+	}
+
+	v, err := fn(*p)
+	if err != nil {
+		// Log conversion error
+		log.WithFields(log.Fields{
+			"string": *p,
+			"error":  err.Error(),
+		}).Warn("Error converting string to " + typ)
+
+		return nil
+	}
+
+	return &v
+}
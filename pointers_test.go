@@ -0,0 +1,84 @@
+// Tests the pointers.go file
+package goutils
+
+import (
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pointers.go", func() {
+	Describe("`Ptr` method", func() {
+		It("Returns a pointer to a copy of the value", func() {
+			// Call method
+			v := 1234
+			actual := Ptr(v)
+
+			// Verify return value
+			Expect(*actual).To(Equal(1234))
+
+			// Verify the pointer doesn't alias the original value
+			*actual = 5678
+			Expect(v).To(Equal(1234))
+		})
+	})
+
+	Describe("`Deref` method", func() {
+		It("Returns the value a non-nil pointer points to", func() {
+			Expect(Deref(Ptr("foo"), "bar")).To(Equal("foo"))
+		})
+
+		It("Returns the default value for a nil pointer", func() {
+			Expect(Deref(nil, "bar")).To(Equal("bar"))
+		})
+	})
+
+	Describe("Pointer to string conversion methods", func() {
+		Context("When the pointer is nil", func() {
+			It("Returns nil", func() {
+				Expect(BoolPtr2StringPtr(nil)).To(BeNil())
+				Expect(Float64Ptr2StringPtr(nil)).To(BeNil())
+				Expect(IntPtr2StringPtr(nil)).To(BeNil())
+				Expect(Int64Ptr2StringPtr(nil)).To(BeNil())
+			})
+		})
+
+		Context("When the pointer is non-nil", func() {
+			It("Returns a pointer to the converted value", func() {
+				Expect(*BoolPtr2StringPtr(Ptr(true))).To(Equal("true"))
+				Expect(*Float64Ptr2StringPtr(Ptr(234.567))).To(Equal("234.567"))
+				Expect(*IntPtr2StringPtr(Ptr(234))).To(Equal("234"))
+				Expect(*Int64Ptr2StringPtr(Ptr(int64(1234)))).To(Equal("1234"))
+			})
+		})
+	})
+
+	Describe("String pointer conversion methods", func() {
+		Context("When the pointer is nil", func() {
+			It("Returns nil", func() {
+				Expect(StringPtr2BoolPtr(nil)).To(BeNil())
+				Expect(StringPtr2Float64Ptr(nil)).To(BeNil())
+				Expect(StringPtr2IntPtr(nil)).To(BeNil())
+				Expect(StringPtr2Int64Ptr(nil)).To(BeNil())
+			})
+		})
+
+		Context("When the string can't be converted", func() {
+			It("Returns nil", func() {
+				Expect(StringPtr2BoolPtr(Ptr("foo"))).To(BeNil())
+				Expect(StringPtr2Float64Ptr(Ptr("foo"))).To(BeNil())
+				Expect(StringPtr2IntPtr(Ptr("foo"))).To(BeNil())
+				Expect(StringPtr2Int64Ptr(Ptr("foo"))).To(BeNil())
+			})
+		})
+
+		Context("When the string can be converted", func() {
+			It("Returns a pointer to the converted value", func() {
+				Expect(*StringPtr2BoolPtr(Ptr("true"))).To(BeTrue())
+				Expect(*StringPtr2Float64Ptr(Ptr("12.34"))).To(Equal(12.34))
+				Expect(*StringPtr2IntPtr(Ptr("1234"))).To(Equal(1234))
+				Expect(*StringPtr2Int64Ptr(Ptr("1234"))).To(Equal(int64(1234)))
+			})
+		})
+	})
+})
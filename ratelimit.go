@@ -0,0 +1,210 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type (
+	// RateLimitConfig contains a set of configuration settings
+	// to be used with rate limiters
+	RateLimitConfig struct {
+		Adaptive bool                       // Whether to adapt to `X-RateLimit-*` and `Retry-After` response headers
+		Burst    int                        // The maximum number of requests that can be made at once
+		Key      func(*http.Request) string // Returns the key a request is limited by, if set. Defaults to the URL's host
+		Rate     float64                    // The number of requests allowed per second, per key
+	}
+
+	// RateLimiter paces requests using a token bucket for each key (by default, each host)
+	// NOTE: Safe for concurrent use, and meant to be shared by requests through `RequestConfig.RateLimiter`
+	// or by clients through `Transport`
+	RateLimiter struct {
+		buckets map[string]*tokenBucket // The bucket of each key
+		config  *RateLimitConfig        // The settings of the limiter
+		mu      sync.Mutex              // Guards buckets
+	}
+
+	// rateLimitTransport is an `http.RoundTripper` that waits on a rate limiter before each request
+	rateLimitTransport struct {
+		limiter *RateLimiter      // The limiter to wait on
+		next    http.RoundTripper // The transport that sends requests
+	}
+
+	// tokenBucket tracks the tokens available to a single key
+	tokenBucket struct {
+		blockedUntil time.Time // When requests may resume after the server asked to stop
+		last         time.Time // When tokens were last added
+		tokens       float64   // The number of tokens available
+	}
+)
+
+// NewRateLimitConfig returns a RateLimitConfig struct with
+// default settings set for each of it's properties
+func NewRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Adaptive: false,
+		Burst:    1,
+		Key:      nil,
+		Rate:     10,
+	}
+}
+
+// NewRateLimiter returns a new RateLimiter using the settings of a config
+// NOTE: A nil config uses the defaults from `NewRateLimitConfig`
+func NewRateLimiter(c *RateLimitConfig) *RateLimiter {
+	if c == nil {
+		c = NewRateLimitConfig()
+	}
+
+	return &RateLimiter{
+		buckets: make(map[string]*tokenBucket),
+		config:  c,
+	}
+}
+
+// Allow takes a token for a key if one is available, returning false otherwise
+func (l *RateLimiter) Allow(key string) bool {
+	return l.reserve(key, time.Now()) == 0
+}
+
+// Key returns the key a request is limited by
+func (l *RateLimiter) Key(req *http.Request) string {
+	if l.config.Key != nil {
+		return l.config.Key(req)
+	}
+
+	return req.URL.Host
+}
+
+// Transport returns an `http.RoundTripper` that waits on the limiter before each request
+// sent by another transport, allowing the limiter to be attached to a client
+// NOTE: A nil transport uses `http.DefaultTransport`
+func (l *RateLimiter) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{limiter: l, next: next}
+}
+
+// Update adapts a key's bucket to the rate limit headers of a response: no more tokens than
+// `X-RateLimit-Remaining` are kept, and requests are paused until `X-RateLimit-Reset`
+// once none remain, or for the delay of a 429 response's `Retry-After`
+// NOTE: `X-RateLimit-Reset` may be either a Unix timestamp or a number of seconds
+func (l *RateLimiter) Update(key string, res *http.Response) {
+	now := time.Now()
+	until := time.Time{}
+	remaining, err := strconv.ParseFloat(res.Header.Get("X-RateLimit-Remaining"), 64)
+	hasRemaining := err == nil && remaining >= 0
+
+	if hasRemaining && remaining < 1 {
+		if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			// Treat large values as timestamps, and small values as delays
+			if reset > 1e9 {
+				until = time.Unix(reset, 0)
+			} else {
+				until = now.Add(time.Duration(reset) * time.Second)
+			}
+		}
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		if after, ok := retryAfter(res); ok && now.Add(after).After(until) {
+			until = now.Add(after)
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(key, now)
+
+	if hasRemaining {
+		b.tokens = min(b.tokens, remaining)
+	}
+
+	if until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
+
+// Wait blocks until a token for a key is available and takes it,
+// returning early with the context's error if it's cancelled
+func (l *RateLimiter) Wait(ctx context.Context, key string) error {
+	for {
+		delay := l.reserve(key, time.Now())
+		if delay == 0 {
+			return nil
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// bucket returns the bucket of a key with tokens added for the time passed, creating it if needed
+// NOTE: Must be called with the lock held
+func (l *RateLimiter) bucket(key string, now time.Time) *tokenBucket {
+	burst := float64(max(l.config.Burst, 1))
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{last: now, tokens: burst}
+		l.buckets[key] = b
+	}
+
+	// Add tokens for the time passed, up to the burst size
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.tokens+elapsed.Seconds()*l.config.Rate, burst)
+		b.last = now
+	}
+
+	return b
+}
+
+// reserve takes a token for a key if one is available, returning 0,
+// or returns how long to wait before trying again otherwise
+func (l *RateLimiter) reserve(key string, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(key, now)
+
+	if now.Before(b.blockedUntil) {
+		return b.blockedUntil.Sub(now)
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	// Wait for the next token, or a while if tokens are never added
+	if l.config.Rate <= 0 {
+		return time.Second
+	}
+
+	return max(time.Duration((1-b.tokens)/l.config.Rate*float64(time.Second)), time.Millisecond)
+}
+
+// RoundTrip implements `http.RoundTripper`
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := t.limiter.Key(req)
+
+	if err := t.limiter.Wait(req.Context(), key); err != nil {
+		return nil, err
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err == nil && t.limiter.config.Adaptive {
+		t.limiter.Update(key, res)
+	}
+
+	return res, err
+}
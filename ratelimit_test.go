@@ -0,0 +1,225 @@
+// Tests the ratelimit.go file
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ratelimit.go", func() {
+	var (
+		// Limiter to test against
+		limiter *RateLimiter
+	)
+
+	BeforeEach(func() {
+		// Set limiter
+		limiter = NewRateLimiter(&RateLimitConfig{Adaptive: true, Burst: 2, Rate: 50})
+	})
+
+	Describe("`NewRateLimitConfig` method", func() {
+		It("Returns a valid rate limit config struct", func() {
+			// Call method
+			c := NewRateLimitConfig()
+
+			// Verify rate limit config was properly created and returned
+			Expect(c.Adaptive).To(BeFalse())
+			Expect(c.Burst).To(Equal(1))
+			Expect(c.Key).To(BeNil())
+			Expect(c.Rate).To(Equal(10.0))
+		})
+	})
+
+	Describe("`NewRateLimiter` method", func() {
+		It("Uses the default config when none is passed in", func() {
+			Expect(NewRateLimiter(nil).config).To(Equal(NewRateLimitConfig()))
+		})
+	})
+
+	Describe("`Allow` method", func() {
+		It("Allows bursts, then refills tokens over time", func() {
+			// Verify burst is allowed
+			Expect(limiter.Allow("foo")).To(BeTrue())
+			Expect(limiter.Allow("foo")).To(BeTrue())
+			Expect(limiter.Allow("foo")).To(BeFalse())
+
+			// Verify other keys are unaffected
+			Expect(limiter.Allow("bar")).To(BeTrue())
+
+			// Verify a token is added after waiting
+			time.Sleep(25 * time.Millisecond)
+			Expect(limiter.Allow("foo")).To(BeTrue())
+		})
+	})
+
+	Describe("`Key` method", func() {
+		It("Returns the request's host, or a custom key", func() {
+			// Create request
+			req, _ := http.NewRequest("GET", "http://example.com:8080/path", nil)
+
+			// Verify return values
+			Expect(limiter.Key(req)).To(Equal("example.com:8080"))
+
+			limiter.config.Key = func(req *http.Request) string {
+				return req.URL.Path
+			}
+			Expect(limiter.Key(req)).To(Equal("/path"))
+		})
+	})
+
+	Describe("`Wait` method", func() {
+		It("Paces requests beyond the burst", func() {
+			// Call method
+			start := time.Now()
+			for i := 0; i < 4; i++ {
+				Expect(limiter.Wait(context.Background(), "foo")).To(Succeed())
+			}
+
+			// Verify requests beyond the burst waited (2 tokens at 50 per second)
+			Expect(time.Since(start)).To(BeNumerically(">=", 35*time.Millisecond))
+		})
+
+		It("Returns an error when the context is cancelled", func() {
+			// Use up burst and stop adding tokens
+			limiter.config.Rate = 0
+			limiter.Allow("foo")
+			limiter.Allow("foo")
+
+			// Create context
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			// Call method
+			err := limiter.Wait(ctx, "foo")
+
+			// Verify return value
+			Expect(err).To(Equal(context.DeadlineExceeded))
+		})
+	})
+
+	Describe("`Update` method", func() {
+		It("Limits tokens to the remaining requests", func() {
+			// Call method
+			limiter.Update("foo", &http.Response{Header: http.Header{"X-Ratelimit-Remaining": {"1"}}})
+
+			// Verify tokens were limited
+			Expect(limiter.Allow("foo")).To(BeTrue())
+			Expect(limiter.Allow("foo")).To(BeFalse())
+		})
+
+		It("Pauses requests until the reset once none remain", func() {
+			// Call method with delays and timestamps
+			limiter.Update("foo", &http.Response{Header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {"60"},
+			}})
+			limiter.Update("bar", &http.Response{Header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)},
+			}})
+
+			// Verify requests are paused
+			Expect(limiter.Allow("foo")).To(BeFalse())
+			Expect(limiter.reserve("foo", time.Now())).To(BeNumerically("~", time.Minute, time.Second))
+			Expect(limiter.reserve("bar", time.Now())).To(BeNumerically("~", time.Hour, 2*time.Second))
+		})
+
+		It("Pauses requests for the delay of a 429 response", func() {
+			// Call method
+			limiter.Update("foo", &http.Response{Header: http.Header{"Retry-After": {"30"}}, StatusCode: 429})
+
+			// Verify requests are paused
+			Expect(limiter.Allow("foo")).To(BeFalse())
+			Expect(limiter.reserve("foo", time.Now())).To(BeNumerically("~", 30*time.Second, time.Second))
+		})
+
+		It("Ignores responses without rate limit headers", func() {
+			// Call method
+			limiter.Update("foo", &http.Response{Header: http.Header{}, StatusCode: 200})
+
+			// Verify burst is still allowed
+			Expect(limiter.Allow("foo")).To(BeTrue())
+			Expect(limiter.Allow("foo")).To(BeTrue())
+		})
+	})
+
+	Describe("`Transport` method", func() {
+		It("Waits on the limiter and adapts to responses", func() {
+			// Create client
+			attempts := 0
+			client := &http.Client{
+				Transport: limiter.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					attempts++
+
+					return &http.Response{
+						Body:       newTrackingBody(0),
+						Header:     http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"60"}},
+						StatusCode: 200,
+					}, nil
+				})),
+			}
+
+			// Make requests
+			res, err := client.Get("http://example.com")
+			Expect(err).To(Not(HaveOccurred()))
+			CloseResponse(res)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			req, _ := http.NewRequestWithContext(ctx, "GET", "http://example.com", nil)
+			_, err = client.Do(req)
+
+			// Verify second request waited for the reset
+			Expect(err).To(HaveOccurred())
+			Expect(attempts).To(Equal(1))
+		})
+
+		It("Uses the default transport when none is passed in", func() {
+			Expect(limiter.Transport(nil).(*rateLimitTransport).next).To(Equal(http.DefaultTransport))
+		})
+	})
+
+	Describe("Making requests with a rate limiter", func() {
+		It("Waits on the limiter before each request", func() {
+			// Create config
+			attempts := 0
+			c := &RequestConfig{
+				Client: &http.Client{
+					Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						attempts++
+
+						return &http.Response{
+							Body:       newTrackingBody(0),
+							Header:     http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"60"}},
+							StatusCode: 200,
+						}, nil
+					}),
+				},
+				Method:      "GET",
+				RateLimiter: limiter,
+				URL:         "http://example.com",
+			}
+
+			// Make requests
+			_, err := GetStatusCodeForRequest(c)
+			Expect(err).To(Not(HaveOccurred()))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err = GetStatusCodeForRequestContext(ctx, c)
+
+			// Verify second request waited for the reset
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(attempts).To(Equal(1))
+		})
+	})
+})
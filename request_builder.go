@@ -0,0 +1,159 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type (
+	// RequestBuilder builds a RequestConfig using chained method calls,
+	// validating the final URL when built
+	RequestBuilder struct {
+		config *RequestConfig // The config being built
+	}
+)
+
+// NewRequestBuilder returns a RequestBuilder for a method and URL,
+// starting from the defaults of `NewRequestConfig`
+func NewRequestBuilder(method, url string) *RequestBuilder {
+	c := NewRequestConfig()
+	c.Method = method
+	c.URL = url
+
+	return &RequestBuilder{config: c}
+}
+
+// Body sets the body of the request, along with it's content type
+func (b *RequestBuilder) Body(body io.Reader, contentType string) *RequestBuilder {
+	b.config.Body = body
+	b.config.ContentType = contentType
+
+	return b
+}
+
+// Build validates the final URL of the request (including any query parameters)
+// and returns the built config
+// NOTE: The URL must be absolute, with an http or https scheme and a host
+func (b *RequestBuilder) Build() (*RequestConfig, error) {
+	raw, err := b.config.requestURL()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("URL '%s' must use the http or https scheme", raw)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("URL '%s' must include a host", raw)
+	}
+
+	return b.config, nil
+}
+
+// Breaker sets the circuit breaker consulted before making the request
+func (b *RequestBuilder) Breaker(breaker *CircuitBreaker) *RequestBuilder {
+	b.config.Breaker = breaker
+
+	return b
+}
+
+// Client sets the HTTP client used to make the request
+func (b *RequestBuilder) Client(client *http.Client) *RequestBuilder {
+	b.config.Client = client
+
+	return b
+}
+
+// Context sets the context used to control cancellation and deadlines of the request
+func (b *RequestBuilder) Context(ctx context.Context) *RequestBuilder {
+	b.config.Context = ctx
+
+	return b
+}
+
+// Cookie adds a cookie to the request
+func (b *RequestBuilder) Cookie(cookie *http.Cookie) *RequestBuilder {
+	b.config.Cookies = append(b.config.Cookies, cookie)
+
+	return b
+}
+
+// Header adds a value for a header to the request
+func (b *RequestBuilder) Header(key, value string) *RequestBuilder {
+	if b.config.Headers == nil {
+		b.config.Headers = make(http.Header)
+	}
+
+	b.config.Headers.Add(key, value)
+
+	return b
+}
+
+// Query adds a value for a query parameter to the request
+func (b *RequestBuilder) Query(key, value string) *RequestBuilder {
+	if b.config.Query == nil {
+		b.config.Query = make(url.Values)
+	}
+
+	b.config.Query.Add(key, value)
+
+	return b
+}
+
+// QueryValues adds a set of values for query parameters to the request
+// NOTE: Useful with `Struct2Values` for building query strings from typed structs
+func (b *RequestBuilder) QueryValues(values url.Values) *RequestBuilder {
+	for k, vs := range values {
+		for _, v := range vs {
+			b.Query(k, v)
+		}
+	}
+
+	return b
+}
+
+// RateLimiter sets the rate limiter waited on before making the request
+func (b *RequestBuilder) RateLimiter(limiter *RateLimiter) *RequestBuilder {
+	b.config.RateLimiter = limiter
+
+	return b
+}
+
+// Retry sets the policy used to retry failed requests
+func (b *RequestBuilder) Retry(policy *RetryPolicy) *RequestBuilder {
+	b.config.Retry = policy
+
+	return b
+}
+
+// TLS sets the TLS settings used to make the request
+func (b *RequestBuilder) TLS(tls *TLSConfig) *RequestBuilder {
+	b.config.TLS = tls
+
+	return b
+}
+
+// Timeout sets the timeout, in seconds, for the request
+func (b *RequestBuilder) Timeout(timeout int) *RequestBuilder {
+	b.config.Timeout = timeout
+
+	return b
+}
+
+// Timeouts sets the sub-second and per-phase timeouts for the request
+func (b *RequestBuilder) Timeouts(timeouts *TimeoutConfig) *RequestBuilder {
+	b.config.Timeouts = timeouts
+
+	return b
+}
@@ -0,0 +1,145 @@
+// Tests the request_builder.go file
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("request_builder.go", func() {
+	Describe("`NewRequestBuilder` method", func() {
+		It("Starts from the default request config", func() {
+			// Call method
+			c, err := NewRequestBuilder("POST", "http://example.com").Build()
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(c.Method).To(Equal("POST"))
+			Expect(c.URL).To(Equal("http://example.com"))
+			Expect(c.ContentType).To(Equal("application/json"))
+			Expect(c.Timeout).To(Equal(5))
+		})
+	})
+
+	Describe("`Build` method", func() {
+		Context("When the final URL is invalid", func() {
+			var (
+				// Input for `Build` input
+				input []*RequestBuilder
+			)
+
+			BeforeEach(func() {
+				// Set input
+				input = []*RequestBuilder{
+					NewRequestBuilder("GET", ":"),
+					NewRequestBuilder("GET", ":").Query("foo", "bar"),
+					NewRequestBuilder("GET", "ftp://example.com"),
+					NewRequestBuilder("GET", "/relative/path"),
+					NewRequestBuilder("GET", "http://"),
+				}
+			})
+
+			It("Returns an error", func() {
+				// Loop through test data
+				for _, input := range input {
+					// Call method
+					_, err := input.Build()
+
+					// Verify return value
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+
+		Context("When the final URL is valid", func() {
+			It("Returns a config with every setting applied", func() {
+				// Create settings
+				client := &http.Client{}
+				ctx := context.Background()
+				body := strings.NewReader("foo")
+				breaker := NewCircuitBreaker(nil)
+				limiter := NewRateLimiter(nil)
+				retry := NewRetryPolicy()
+				timeouts := &TimeoutConfig{Overall: 250 * time.Millisecond}
+				tls := NewTLSConfig()
+
+				// Call method
+				c, err := NewRequestBuilder("PUT", "https://example.com/path?a=1").
+					Body(body, "text/plain").
+					Breaker(breaker).
+					Client(client).
+					Context(ctx).
+					Cookie(&http.Cookie{Name: "session", Value: "abc"}).
+					Header("Authorization", "Bearer token").
+					Query("a", "2").
+					QueryValues(url.Values{"b": []string{"3"}}).
+					RateLimiter(limiter).
+					Retry(retry).
+					TLS(tls).
+					Timeout(10).
+					Timeouts(timeouts).
+					Build()
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(c.Body).To(Equal(body))
+				Expect(c.Breaker).To(Equal(breaker))
+				Expect(c.Client).To(Equal(client))
+				Expect(c.ContentType).To(Equal("text/plain"))
+				Expect(c.Context).To(Equal(ctx))
+				Expect(c.Cookies).To(HaveLen(1))
+				Expect(c.Headers.Get("Authorization")).To(Equal("Bearer token"))
+				Expect(c.Query).To(Equal(url.Values{"a": []string{"2"}, "b": []string{"3"}}))
+				Expect(c.RateLimiter).To(Equal(limiter))
+				Expect(c.Retry).To(Equal(retry))
+				Expect(c.Timeout).To(Equal(10))
+				Expect(c.Timeouts).To(Equal(timeouts))
+				Expect(c.TLS).To(Equal(tls))
+			})
+		})
+	})
+
+	Describe("Making requests with built configs", func() {
+		It("Sends headers, query parameters and cookies", func() {
+			// Create test server to mock responses
+			server := getMockServer("echo")
+			defer server.Close()
+
+			// Build config
+			c, err := NewRequestBuilder("POST", server.URL+"?a=1").
+				Body(strings.NewReader("foo"), "text/plain").
+				Header("Accept", "application/json").
+				Header("Content-Type", "application/xml").
+				Header("X-Trace", "1").
+				Header("X-Trace", "2").
+				Query("a", "2").
+				Query("b", "hello world").
+				Cookie(&http.Cookie{Name: "session", Value: "abc"}).
+				Build()
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Make request
+			res, err := DoRequest(c)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Verify request was sent as configured
+			actual := &EchoResponse{}
+			Expect(res.JSON(actual)).To(Succeed())
+			Expect(actual.Method).To(Equal("POST"))
+			Expect(actual.Body).To(Equal("foo"))
+			Expect(actual.Query).To(Equal(url.Values{"a": []string{"1", "2"}, "b": []string{"hello world"}}))
+			Expect(actual.Headers.Get("Accept")).To(Equal("application/json"))
+			Expect(actual.Headers.Get("Content-Type")).To(Equal("application/xml"))
+			Expect(actual.Headers.Values("X-Trace")).To(Equal([]string{"1", "2"}))
+			Expect(actual.Headers.Get("Cookie")).To(Equal("session=abc"))
+		})
+	})
+})
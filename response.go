@@ -0,0 +1,108 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+)
+
+type (
+	// Response wraps an HTTP response, guaranteeing it's body is closed once read
+	// and providing convenience methods for reading it
+	// NOTE: Reading methods read the whole body once and cache it, so they can be called repeatedly.
+	// Responses that aren't read must still be closed with `Close`
+	Response struct {
+		*http.Response
+
+		body []byte    // The cached body, once read
+		err  error     // The error that occurred while reading the body, if any
+		once sync.Once // Ensures the body is only read once
+	}
+)
+
+var (
+	// MaxDrainBytes is the maximum number of unread bytes discarded from a response body before closing it
+	// NOTE: Draining a body allows it's connection to be reused, but draining large bodies
+	// costs more than opening a new connection
+	MaxDrainBytes int64 = 64 << 10
+)
+
+// CloseResponse drains (up to `MaxDrainBytes` of) and closes an HTTP response's body
+// so that it's connection can be reused
+// NOTE: Safe to call with a nil response
+func CloseResponse(res *http.Response) error {
+	if res == nil || res.Body == nil {
+		return nil
+	}
+
+	// NOTE: Drain errors are ignored, as closing is what matters
+	io.CopyN(io.Discard, res.Body, MaxDrainBytes)
+
+	return res.Body.Close()
+}
+
+// DoRequest makes an HTTP request using `MakeRequest` and returns the response as a Response
+// NOTE: Uses the config's `Context`, if set
+func DoRequest(c *RequestConfig) (*Response, error) {
+	return DoRequestContext(c.context(), c)
+}
+
+// DoRequestContext is the same as `DoRequest`, but uses a context
+// to control cancellation and deadlines of the request
+func DoRequestContext(ctx context.Context, c *RequestConfig) (*Response, error) {
+	res, err := MakeRequestContext(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResponse(res), nil
+}
+
+// NewResponse returns a Response wrapping an HTTP response
+func NewResponse(res *http.Response) *Response {
+	return &Response{Response: res}
+}
+
+// Bytes reads and returns the response's body, closing it
+func (r *Response) Bytes() ([]byte, error) {
+	r.once.Do(func() {
+		defer CloseResponse(r.Response)
+
+		r.body, r.err = io.ReadAll(r.Response.Body)
+	})
+
+	return r.body, r.err
+}
+
+// Close drains (up to `MaxDrainBytes` of) and closes the response's body, if it hasn't been read
+// NOTE: Safe to call multiple times, and after reading the body
+func (r *Response) Close() error {
+	var err error
+
+	r.once.Do(func() {
+		err = CloseResponse(r.Response)
+	})
+
+	return err
+}
+
+// JSON reads the response's body, closing it, and decodes it as JSON into a value
+func (r *Response) JSON(v interface{}) error {
+	b, err := r.Bytes()
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// String reads and returns the response's body as a string, closing it
+func (r *Response) String() (string, error) {
+	b, err := r.Bytes()
+
+	return string(b), err
+}
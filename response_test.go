@@ -0,0 +1,142 @@
+// Tests the response.go file
+package goutils
+
+import (
+	// Standard lib
+	"net/http"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("response.go", func() {
+	var (
+		// Body of responses to test against
+		body *trackingBody
+		// Client returning responses with the body
+		client *http.Client
+	)
+
+	BeforeEach(func() {
+		// Set body and client
+		body = newTrackingBody(10)
+		client = &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				return &http.Response{Body: body, StatusCode: 200}, nil
+			}),
+		}
+	})
+
+	Describe("`CloseResponse` method", func() {
+		It("Drains and closes the body", func() {
+			// Call method
+			err := CloseResponse(&http.Response{Body: body})
+
+			// Verify body was drained and closed
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(body.BytesRead).To(Equal(int64(10)))
+			Expect(body.Closed).To(BeTrue())
+		})
+
+		It("Caps how much of the body is drained", func() {
+			// Create large body
+			body = newTrackingBody(int(MaxDrainBytes) * 2)
+
+			// Call method
+			CloseResponse(&http.Response{Body: body})
+
+			// Verify body was partially drained and closed
+			Expect(body.BytesRead).To(Equal(MaxDrainBytes))
+			Expect(body.Closed).To(BeTrue())
+		})
+
+		It("Handles nil responses and bodies", func() {
+			Expect(CloseResponse(nil)).To(Succeed())
+			Expect(CloseResponse(&http.Response{})).To(Succeed())
+		})
+	})
+
+	Describe("`GetStatusCodeForRequest` method", func() {
+		It("Closes the response body", func() {
+			// Call method
+			code, err := GetStatusCodeForRequest(&RequestConfig{Client: client, Method: "GET", URL: "http://example.com"})
+
+			// Verify return values and body was closed
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+			Expect(body.Closed).To(BeTrue())
+		})
+	})
+
+	Describe("`DoRequest` method", func() {
+		Context("An error occurred when making the request", func() {
+			It("Returns an error", func() {
+				// Call method
+				_, err := DoRequest(&RequestConfig{Method: "GET", URL: ":"})
+
+				// Verify return value
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("The server returned a valid response", func() {
+			It("Returns a response", func() {
+				// Call method
+				res, err := DoRequest(&RequestConfig{Method: "GET", URL: getMockServer("bad-request").URL})
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(res.StatusCode).To(Equal(400))
+
+				// Verify body can be decoded
+				v := map[string]int{}
+				Expect(res.JSON(&v)).To(Succeed())
+				Expect(v).To(Equal(map[string]int{"code": 400}))
+			})
+		})
+	})
+
+	Describe("`Response` type", func() {
+		var (
+			// Response to test against
+			res *Response
+		)
+
+		BeforeEach(func() {
+			// Set response
+			r, err := client.Get("http://example.com")
+			Expect(err).To(Not(HaveOccurred()))
+
+			res = NewResponse(r)
+		})
+
+		It("Reads and closes the body", func() {
+			// Call methods
+			b, err := res.Bytes()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(b).To(HaveLen(10))
+
+			s, err := res.String()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(s).To(HaveLen(10))
+
+			// Verify body was read once and closed
+			Expect(body.BytesRead).To(Equal(int64(10)))
+			Expect(body.Closed).To(BeTrue())
+			Expect(res.Close()).To(Succeed())
+		})
+
+		It("Returns an error when the body isn't valid JSON", func() {
+			Expect(res.JSON(&map[string]interface{}{})).To(Not(Succeed()))
+		})
+
+		It("Closes an unread body", func() {
+			// Call method
+			Expect(res.Close()).To(Succeed())
+
+			// Verify body was closed
+			Expect(body.Closed).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,209 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+type (
+	// RetryPolicy contains a set of configuration settings
+	// used to retry failed HTTP requests with exponential backoff
+	RetryPolicy struct {
+		BaseDelay            time.Duration // The delay before the first retry, doubled for each retry after it
+		Jitter               bool          // Whether to use "full jitter", waiting a random delay between 0 and the backoff delay
+		MaxAttempts          int           // The maximum number of attempts, including the first
+		MaxDelay             time.Duration // The maximum delay between attempts, including delays from `Retry-After` headers
+		RetryNonIdempotent   bool          // Whether to retry requests with non-idempotent methods (ex: POST)
+		RetryableStatusCodes []int         // Response status codes that are retried
+	}
+)
+
+// NewRetryPolicy returns a RetryPolicy struct with
+// default settings set for each of it's properties
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		BaseDelay:            200 * time.Millisecond,
+		Jitter:               true,
+		MaxAttempts:          3,
+		MaxDelay:             10 * time.Second,
+		RetryNonIdempotent:   false,
+		RetryableStatusCodes: []int{429, 502, 503, 504},
+	}
+}
+
+// Backoff returns the delay before a retry, where `retry` is 1 for the first retry
+// NOTE: With jitter, the delay is a random value between 0 and the backoff delay
+func (p *RetryPolicy) Backoff(retry int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	// Double the base delay for each retry, stopping at the maximum delay
+	// NOTE: Also stops before overflowing, for policies without a maximum delay
+	delay := p.BaseDelay
+	for i := 1; i < retry && (p.MaxDelay <= 0 || delay < p.MaxDelay) && delay <= math.MaxInt64/2; i++ {
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 {
+		delay = min(delay, p.MaxDelay)
+	}
+
+	if p.Jitter {
+		delay = time.Duration(rand.Int64N(min(int64(delay), math.MaxInt64-1) + 1))
+	}
+
+	return delay
+}
+
+// Retryable returns true if a request with a given method can be retried by the policy
+// NOTE: Only idempotent methods are retried, unless `RetryNonIdempotent` is set
+func (p *RetryPolicy) Retryable(method string) bool {
+	if p.RetryNonIdempotent {
+		return true
+	}
+
+	switch method {
+	case "", http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+// do makes an HTTP request from a config, retrying failed attempts according to the policy
+// NOTE: Request bodies are read fully before the first attempt so they can be replayed for each retry.
+// Only errors that may be transient are retried (see `retryableError`), and the final attempt's response or error is returned as is
+func (p *RetryPolicy) do(ctx context.Context, c *RequestConfig) (*http.Response, error) {
+	if p.MaxAttempts <= 1 || !p.Retryable(c.Method) {
+		return c.do(ctx)
+	}
+
+	// Buffer the body so it can be replayed
+	var body []byte
+	if c.Body != nil {
+		b, err := io.ReadAll(c.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		body = b
+	}
+
+	for attempt := 1; ; attempt++ {
+		// Copy the config to rewind the body for this attempt
+		ac := *c
+		if c.Body != nil {
+			ac.Body = bytes.NewReader(body)
+		}
+
+		res, err := ac.do(ctx)
+
+		// Return the last attempt, or attempts that shouldn't be retried
+		if attempt >= p.MaxAttempts || ctx.Err() != nil || (err != nil && !retryableError(err)) {
+			return res, err
+		}
+
+		if err == nil && !Contains(res.StatusCode, p.RetryableStatusCodes) {
+			return res, nil
+		}
+
+		// Wait before the next attempt, honoring any `Retry-After` header
+		delay := p.Backoff(attempt)
+		if err == nil {
+			if after, ok := retryAfter(res); ok {
+				delay = max(delay, after)
+
+				if p.MaxDelay > 0 {
+					delay = min(delay, p.MaxDelay)
+				}
+			}
+
+			// Release the response's connection before retrying
+			CloseResponse(res)
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryableError returns true if an error may be transient, like network errors, timeouts,
+// and connections that were reset or closed early
+// NOTE: Permanent errors (ex: invalid URLs, unsupported schemes, invalid TLS settings)
+// and requests rejected by a circuit breaker aren't retried
+func retryableError(err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+
+	// Look past the URL error every client error is wrapped in,
+	// since it implements `net.Error` itself
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		err = ue.Err
+	}
+
+	if isTimeout(err) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	// Retry network errors, except DNS errors that won't resolve
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// retryAfter returns the delay requested by a response's `Retry-After` header, if any
+// NOTE: Supports both delays in seconds and HTTP dates
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for a duration, returning early with the context's error if it's cancelled
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,347 @@
+// Tests the retry.go file
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("retry.go", func() {
+	var (
+		// Bodies received by each attempt
+		bodies []string
+		// Client returning the next status code for each attempt
+		client *http.Client
+		// Headers returned with each response
+		headers http.Header
+		// Status codes returned by each attempt, the last repeating
+		statuses []int
+		// Responses returned by the client
+		responses []*trackingBody
+	)
+
+	BeforeEach(func() {
+		// Reset test data
+		bodies, headers, responses = nil, http.Header{}, nil
+		statuses = []int{503, 200}
+
+		client = &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				// Record body
+				body := ""
+				if req.Body != nil {
+					b, _ := io.ReadAll(req.Body)
+					body = string(b)
+				}
+
+				bodies = append(bodies, body)
+
+				// Return next status code
+				status := statuses[min(len(bodies), len(statuses))-1]
+				res := newTrackingBody(10)
+				responses = append(responses, res)
+
+				return &http.Response{Body: res, Header: headers, StatusCode: status}, nil
+			}),
+		}
+	})
+
+	Describe("`NewRetryPolicy` method", func() {
+		It("Returns a valid retry policy struct", func() {
+			// Call method
+			p := NewRetryPolicy()
+
+			// Verify retry policy was properly created and returned
+			Expect(p.BaseDelay).To(Equal(200 * time.Millisecond))
+			Expect(p.Jitter).To(BeTrue())
+			Expect(p.MaxAttempts).To(Equal(3))
+			Expect(p.MaxDelay).To(Equal(10 * time.Second))
+			Expect(p.RetryNonIdempotent).To(BeFalse())
+			Expect(p.RetryableStatusCodes).To(Equal([]int{429, 502, 503, 504}))
+		})
+	})
+
+	Describe("`Backoff` method", func() {
+		It("Doubles the delay for each retry, up to the maximum", func() {
+			// Create policy
+			p := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+			// Verify return values
+			Expect(p.Backoff(1)).To(Equal(time.Second))
+			Expect(p.Backoff(2)).To(Equal(2 * time.Second))
+			Expect(p.Backoff(3)).To(Equal(4 * time.Second))
+			Expect(p.Backoff(4)).To(Equal(5 * time.Second))
+			Expect(p.Backoff(100)).To(Equal(5 * time.Second))
+		})
+
+		It("Returns a random delay up to the backoff delay with jitter", func() {
+			// Create policy
+			p := &RetryPolicy{BaseDelay: time.Second, Jitter: true, MaxDelay: 5 * time.Second}
+
+			// Verify return values
+			for i := 0; i < 100; i++ {
+				Expect(p.Backoff(2)).To(BeNumerically("<=", 2*time.Second))
+			}
+		})
+
+		It("Stops doubling before overflowing without a maximum delay", func() {
+			// Create policy
+			p := &RetryPolicy{BaseDelay: 200 * time.Millisecond}
+
+			// Verify return values
+			Expect(p.Backoff(40)).To(BeNumerically(">", 0))
+			Expect(p.Backoff(1000)).To(Equal(p.Backoff(100)))
+
+			// Verify jitter doesn't panic
+			p.Jitter = true
+			Expect(p.Backoff(1000)).To(BeNumerically(">=", 0))
+			Expect((&RetryPolicy{BaseDelay: math.MaxInt64, Jitter: true}).Backoff(2)).To(BeNumerically(">=", 0))
+		})
+
+		It("Returns no delay without a base delay", func() {
+			Expect((&RetryPolicy{}).Backoff(3)).To(BeZero())
+		})
+	})
+
+	Describe("`Retryable` method", func() {
+		It("Only retries idempotent methods by default", func() {
+			// Create policy
+			p := NewRetryPolicy()
+
+			// Verify return values
+			for _, method := range []string{"", "GET", "HEAD", "OPTIONS", "PUT", "DELETE", "TRACE"} {
+				Expect(p.Retryable(method)).To(BeTrue())
+			}
+
+			for _, method := range []string{"POST", "PATCH"} {
+				Expect(p.Retryable(method)).To(BeFalse())
+			}
+
+			// Verify non-idempotent methods can be retried
+			p.RetryNonIdempotent = true
+			Expect(p.Retryable("POST")).To(BeTrue())
+		})
+	})
+
+	Describe("Making requests with a retry policy", func() {
+		var (
+			// Config to test against
+			c *RequestConfig
+		)
+
+		BeforeEach(func() {
+			// Set config
+			c = &RequestConfig{
+				Client: client,
+				Method: "PUT",
+				Retry:  &RetryPolicy{BaseDelay: time.Millisecond, MaxAttempts: 3, RetryableStatusCodes: []int{503}},
+				URL:    "http://example.com",
+			}
+		})
+
+		It("Retries retryable responses and replays the body", func() {
+			// Set body
+			c.Body = strings.NewReader("foo")
+
+			// Call method
+			code, err := GetStatusCodeForRequest(c)
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+			Expect(bodies).To(Equal([]string{"foo", "foo"}))
+
+			// Verify failed responses were closed
+			Expect(responses[0].Closed).To(BeTrue())
+		})
+
+		It("Returns the last response once out of attempts", func() {
+			// Set status codes
+			statuses = []int{503}
+
+			// Call method
+			code, err := GetStatusCodeForRequest(c)
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(503))
+			Expect(bodies).To(HaveLen(3))
+		})
+
+		It("Doesn't retry other responses", func() {
+			// Set status codes
+			statuses = []int{500}
+
+			// Call method
+			code, _ := GetStatusCodeForRequest(c)
+
+			// Verify return values
+			Expect(code).To(Equal(500))
+			Expect(bodies).To(HaveLen(1))
+		})
+
+		It("Doesn't retry non-idempotent methods by default", func() {
+			// Set method
+			c.Method = "POST"
+
+			// Call method
+			code, _ := GetStatusCodeForRequest(c)
+
+			// Verify return values
+			Expect(code).To(Equal(503))
+			Expect(bodies).To(HaveLen(1))
+		})
+
+		It("Retries errors", func() {
+			// Set client returning an error for the first attempt
+			attempts := 0
+			c.Client = &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					if attempts++; attempts == 1 {
+						return nil, &net.OpError{Err: syscall.ECONNRESET, Net: "tcp", Op: "read"}
+					}
+
+					return &http.Response{Body: newTrackingBody(0), StatusCode: 200}, nil
+				}),
+			}
+
+			// Call method
+			code, err := GetStatusCodeForRequest(c)
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+			Expect(attempts).To(Equal(2))
+		})
+
+		It("Doesn't retry permanent errors", func() {
+			// Set client returning a permanent error
+			attempts := 0
+			c.Client = &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					attempts++
+					return nil, errors.New("Invalid request")
+				}),
+			}
+
+			// Call method
+			_, err := GetStatusCodeForRequest(c)
+
+			// Verify return values
+			Expect(err).To(HaveOccurred())
+			Expect(attempts).To(Equal(1))
+		})
+
+		It("Doesn't retry unsupported schemes", func() {
+			// Set URL and a long delay
+			c.Client = nil
+			c.Retry.BaseDelay = time.Hour
+			c.URL = "ftp://example.com"
+
+			// Call method
+			_, err := GetStatusCodeForRequest(c)
+
+			// Verify return value
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Honors `Retry-After` headers, up to the maximum delay", func() {
+			// Set header and maximum delay
+			headers.Set("Retry-After", "1")
+			c.Retry.MaxDelay = 50 * time.Millisecond
+
+			// Call method
+			start := time.Now()
+			code, err := GetStatusCodeForRequest(c)
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+
+		It("Stops waiting when the context is cancelled", func() {
+			// Set long delay
+			c.Retry.BaseDelay = time.Hour
+
+			// Create context
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			// Call method
+			_, err := GetStatusCodeForRequestContext(ctx, c)
+
+			// Verify return values
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(bodies).To(HaveLen(1))
+		})
+	})
+
+	Describe("`retryableError` method", func() {
+		It("Returns true for errors that may be transient", func() {
+			// Loop through test data
+			for _, err := range []error{
+				&url.Error{Err: io.EOF, Op: "Get", URL: "http://example.com"},
+				&url.Error{Err: context.DeadlineExceeded, Op: "Get", URL: "http://example.com"},
+				&net.OpError{Err: syscall.ECONNREFUSED, Net: "tcp", Op: "dial"},
+				&net.DNSError{IsTemporary: true},
+				io.ErrUnexpectedEOF,
+			} {
+				Expect(retryableError(err)).To(BeTrue())
+			}
+		})
+
+		It("Returns false for permanent errors", func() {
+			// Loop through test data
+			for _, err := range []error{
+				&url.Error{Err: errors.New("unsupported protocol scheme"), Op: "Get", URL: "ftp://example.com"},
+				&net.DNSError{IsNotFound: true},
+				ErrCircuitOpen,
+				errors.New("Invalid request"),
+			} {
+				Expect(retryableError(err)).To(BeFalse())
+			}
+		})
+	})
+
+	Describe("`retryAfter` method", func() {
+		It("Parses delays in seconds and HTTP dates", func() {
+			// Create response
+			res := &http.Response{Header: http.Header{}}
+
+			// Verify missing and invalid values
+			_, ok := retryAfter(res)
+			Expect(ok).To(BeFalse())
+
+			res.Header.Set("Retry-After", "soon")
+			_, ok = retryAfter(res)
+			Expect(ok).To(BeFalse())
+
+			// Verify seconds
+			res.Header.Set("Retry-After", "3")
+			d, ok := retryAfter(res)
+			Expect(ok).To(BeTrue())
+			Expect(d).To(Equal(3 * time.Second))
+
+			// Verify dates
+			res.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+			d, ok = retryAfter(res)
+			Expect(ok).To(BeTrue())
+			Expect(d).To(BeNumerically("~", time.Minute, 2*time.Second))
+		})
+	})
+})
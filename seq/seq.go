@@ -0,0 +1,188 @@
+// Package seq contains lazy iterator helpers built on Go's `iter.Seq` and `iter.Seq2` types,
+// allowing values to be streamed instead of materialised as slices
+package seq
+
+import (
+	// Standard lib
+	"iter"
+
+	// Local
+	goutils "github.com/marksost/go-utils"
+)
+
+// Chunk returns an iterator over chunks of (at most) `n` values, with the last chunk holding any remainder
+// NOTE: Each chunk is a new slice. Yields nothing if `n` is less than 1
+func Chunk[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if n < 1 {
+			return
+		}
+
+		chunk := make([]T, 0, n)
+
+		for v := range seq {
+			if chunk = append(chunk, v); len(chunk) == n {
+				if !yield(chunk) {
+					return
+				}
+
+				chunk = make([]T, 0, n)
+			}
+		}
+
+		// Yield any remainder
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Collect gathers the values of an iterator into a slice
+func Collect[T any](seq iter.Seq[T]) []T {
+	// Form return value
+	ret := make([]T, 0)
+
+	for v := range seq {
+		ret = append(ret, v)
+	}
+
+	return ret
+}
+
+// CollectMap gathers the keys and values of an iterator into a map
+// NOTE: When a key is yielded more than once, the last value wins
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	// Form return value
+	ret := make(map[K]V)
+
+	for k, v := range seq {
+		ret[k] = v
+	}
+
+	return ret
+}
+
+// CollectSet gathers the values of an iterator into a `goutils.Set`, preserving the order they were first seen
+func CollectSet[T comparable](seq iter.Seq[T]) *goutils.Set[T] {
+	ret := goutils.NewSet[T]()
+
+	for v := range seq {
+		ret.Add(v)
+	}
+
+	return ret
+}
+
+// Concat returns an iterator over the values of each iterator passed in, one after another
+func Concat[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for v := range seq {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Contains returns true if an iterator yields a specific value, stopping as soon as it's found
+// NOTE: The streaming equivalent of `goutils.Contains`
+func Contains[T comparable](needle T, seq iter.Seq[T]) bool {
+	for v := range seq {
+		if v == needle {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Filter returns an iterator over the values of another iterator that satisfy a function
+func Filter[T any](seq iter.Seq[T], fn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if fn(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromSlice returns an iterator over the values of a slice
+func FromSlice[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns an iterator over the results of applying a function to the values of another iterator
+func Map[T, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns an iterator over the values of another iterator after the first `n`
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+
+		for v := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns an iterator over (at most) the first `n` values of another iterator
+// NOTE: Stops pulling from the underlying iterator once `n` values have been yielded
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n < 1 {
+			return
+		}
+
+		taken := 0
+
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+
+			if taken++; taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Zip returns an iterator over pairs of values from two iterators, stopping when either runs out
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		// Pull from the second iterator while ranging over the first
+		next, stop := iter.Pull(b)
+		defer stop()
+
+		for va := range a {
+			vb, ok := next()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Test suite setup for the seq package
+package seq_test
+
+import (
+	// Standard lib
+	"testing"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Tests the seq package
+func TestSeq(t *testing.T) {
+	// Register gomega fail handler
+	RegisterFailHandler(Fail)
+
+	// Have go's testing package run package specs
+	RunSpecs(t, "seq suite")
+}
@@ -0,0 +1,143 @@
+// Tests the seq.go file
+// NOTE: Uses an external test package, as `Skip` would otherwise clash with ginkgo's `Skip`
+package seq_test
+
+import (
+	// Standard lib
+	"iter"
+	"maps"
+	"strings"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	// Local
+	"github.com/marksost/go-utils/seq"
+)
+
+// naturals returns an infinite iterator over the natural numbers, recording how many were pulled
+func naturals(pulled *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*pulled++
+
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+var _ = Describe("seq.go", func() {
+	var (
+		// Number of values pulled from the infinite iterator
+		pulled int
+	)
+
+	BeforeEach(func() {
+		// Reset counter
+		pulled = 0
+	})
+
+	Describe("`FromSlice` and `Collect` methods", func() {
+		It("Round-trips a slice", func() {
+			Expect(seq.Collect(seq.FromSlice([]string{"foo", "bar"}))).To(Equal([]string{"foo", "bar"}))
+			Expect(seq.Collect(seq.FromSlice([]string{}))).To(BeEmpty())
+		})
+	})
+
+	Describe("`Map`, `Filter`, `Take` and `Skip` methods", func() {
+		It("Lazily transforms an infinite iterator", func() {
+			// Create pipeline
+			even := func(i int) bool { return i%2 == 0 }
+			s := seq.Take(seq.Skip(seq.Map(seq.Filter(naturals(&pulled), even), func(i int) int { return i * 10 }), 2), 3)
+
+			// Verify nothing was pulled until the pipeline was consumed
+			Expect(pulled).To(Equal(0))
+
+			// Verify pipeline output
+			Expect(seq.Collect(s)).To(Equal([]int{40, 60, 80}))
+			Expect(pulled).To(Equal(9))
+		})
+
+		It("Handles zero values for take", func() {
+			Expect(seq.Collect(seq.Take(naturals(&pulled), 0))).To(BeEmpty())
+			Expect(pulled).To(Equal(0))
+		})
+
+		It("Stops early when the consumer does", func() {
+			// Consume the first value only
+			for range seq.Map(seq.Filter(seq.Skip(naturals(&pulled), 1), func(int) bool { return true }), func(i int) int { return i }) {
+				break
+			}
+
+			// Verify only the needed values were pulled
+			Expect(pulled).To(Equal(2))
+		})
+	})
+
+	Describe("`Chunk` method", func() {
+		It("Groups values into chunks", func() {
+			Expect(seq.Collect(seq.Chunk(seq.FromSlice([]int{1, 2, 3, 4, 5}), 2))).To(Equal([][]int{{1, 2}, {3, 4}, {5}}))
+			Expect(seq.Collect(seq.Chunk(seq.Take(naturals(&pulled), 4), 2))).To(Equal([][]int{{0, 1}, {2, 3}}))
+			Expect(seq.Collect(seq.Chunk(seq.FromSlice([]int{1}), 0))).To(BeEmpty())
+		})
+
+		It("Stops early when the consumer does", func() {
+			Expect(seq.Collect(seq.Take(seq.Chunk(naturals(&pulled), 3), 1))).To(Equal([][]int{{0, 1, 2}}))
+			Expect(pulled).To(Equal(3))
+		})
+	})
+
+	Describe("`Concat` method", func() {
+		It("Yields the values of each iterator in turn", func() {
+			Expect(seq.Collect(seq.Concat(seq.FromSlice([]int{1, 2}), seq.FromSlice([]int{}), seq.FromSlice([]int{3})))).To(Equal([]int{1, 2, 3}))
+			Expect(seq.Collect(seq.Take(seq.Concat(seq.FromSlice([]int{1, 2}), naturals(&pulled)), 3))).To(Equal([]int{1, 2, 0}))
+		})
+	})
+
+	Describe("`Zip` method", func() {
+		It("Pairs values until either iterator runs out", func() {
+			// Collect pairs
+			actual := seq.CollectMap(seq.Zip(seq.FromSlice([]string{"a", "b", "c"}), naturals(&pulled)))
+
+			// Verify pairs
+			Expect(actual).To(Equal(map[string]int{"a": 0, "b": 1, "c": 2}))
+		})
+
+		It("Stops early when the consumer does", func() {
+			// Consume the first pair only
+			for range seq.Zip(naturals(&pulled), seq.FromSlice([]int{1, 2, 3})) {
+				break
+			}
+
+			// Verify only the needed values were pulled
+			Expect(pulled).To(Equal(1))
+		})
+	})
+
+	Describe("`CollectMap` method", func() {
+		It("Collects keys and values into a map", func() {
+			Expect(seq.CollectMap(maps.All(map[string]int{"a": 1}))).To(Equal(map[string]int{"a": 1}))
+		})
+	})
+
+	Describe("`CollectSet` method", func() {
+		It("Collects unique values into a set", func() {
+			// Collect set
+			actual := seq.CollectSet(seq.Map(seq.FromSlice([]string{"Foo", "bar", "FOO"}), strings.ToLower))
+
+			// Verify set
+			Expect(actual.Slice()).To(Equal([]string{"foo", "bar"}))
+		})
+	})
+
+	Describe("`Contains` method", func() {
+		It("Stops as soon as the value is found", func() {
+			Expect(seq.Contains(5, naturals(&pulled))).To(BeTrue())
+			Expect(pulled).To(Equal(6))
+			Expect(seq.Contains("baz", seq.FromSlice([]string{"foo", "bar"}))).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,317 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"encoding/json"
+	"iter"
+	"sync"
+)
+
+type (
+	// Set is a collection of unique values that preserves the order values were added in
+	// NOTE: The zero value is an empty set ready to use, including as a (non-pointer) struct field.
+	// A nil set is treated as an empty set by every method except `Add`, `MarshalJSON` and `UnmarshalJSON`,
+	// and is encoded as `null` by `json.Marshal`. Not safe for concurrent use, see `SyncSet`
+	Set[T comparable] struct {
+		index  map[T]int // The position of each value within `values`
+		values []T       // The set's values, in insertion order
+	}
+
+	// SyncSet is a Set that is safe for concurrent use
+	// NOTE: The zero value is an empty set ready to use. Must not be copied after first use,
+	// so struct fields should be pointers (which are also needed for it to be encoded as a JSON array)
+	SyncSet[T comparable] struct {
+		mu  sync.RWMutex // Guards the underlying set
+		set Set[T]       // The underlying set
+	}
+)
+
+// NewSet returns a Set containing the values passed in
+// NOTE: Accepts a slice via `NewSet(s...)`, duplicates are dropped
+func NewSet[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{
+		index:  make(map[T]int, len(values)),
+		values: make([]T, 0, len(values)),
+	}
+
+	s.Add(values...)
+
+	return s
+}
+
+// NewSyncSet returns a SyncSet containing the values passed in
+func NewSyncSet[T comparable](values ...T) *SyncSet[T] {
+	return &SyncSet[T]{set: *NewSet(values...)}
+}
+
+// Add adds values to the set, ignoring any that are already present
+func (s *Set[T]) Add(values ...T) {
+	if s.index == nil {
+		s.index = make(map[T]int, len(values))
+	}
+
+	for _, v := range values {
+		if _, ok := s.index[v]; !ok {
+			s.index[v] = len(s.values)
+			s.values = append(s.values, v)
+		}
+	}
+}
+
+// All returns an iterator over the set's values, in insertion order
+// NOTE: The set must not be modified during iteration
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil {
+			return
+		}
+
+		for _, v := range s.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all values from the set
+func (s *Set[T]) Clear() {
+	if s == nil {
+		return
+	}
+
+	s.index = nil
+	s.values = nil
+}
+
+// Clone returns a copy of the set
+func (s *Set[T]) Clone() *Set[T] {
+	if s == nil {
+		return NewSet[T]()
+	}
+
+	return NewSet(s.values...)
+}
+
+// Difference returns a new set containing the values of this set that aren't in another
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return s.filter(func(v T) bool {
+		return !other.Has(v)
+	})
+}
+
+// Equal returns true if two sets contain the same values, regardless of order
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// Has returns true if the set contains a value
+func (s *Set[T]) Has(v T) bool {
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.index[v]
+	return ok
+}
+
+// Intersect returns a new set containing the values of this set that are also in another
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	return s.filter(other.Has)
+}
+
+// IsSubset returns true if every value of this set is also in another
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s == nil {
+		return true
+	}
+
+	for _, v := range s.values {
+		if !other.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of values in the set
+func (s *Set[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+
+	return len(s.values)
+}
+
+// MarshalJSON encodes the set as a JSON array, in insertion order
+// NOTE: Uses a value receiver so that sets are encoded as arrays even when they aren't addressable
+// (ex: fields of structs or values of maps passed by value)
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	// NOTE: Encode empty sets as `[]` instead of `null`
+	if s.values == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(s.values)
+}
+
+// Remove removes values from the set, ignoring any that aren't present
+// NOTE: O(n) per value removed, as later values are shifted to preserve order
+func (s *Set[T]) Remove(values ...T) {
+	if s == nil {
+		return
+	}
+
+	for _, v := range values {
+		i, ok := s.index[v]
+		if !ok {
+			continue
+		}
+
+		delete(s.index, v)
+		s.values = append(s.values[:i], s.values[i+1:]...)
+
+		// Re-index shifted values
+		for ; i < len(s.values); i++ {
+			s.index[s.values[i]] = i
+		}
+	}
+}
+
+// Slice returns the set's values as a slice, in insertion order
+// NOTE: Returns a copy, so a `Set[string]`'s values can be passed to `SliceContains`
+func (s *Set[T]) Slice() []T {
+	if s == nil {
+		return []T{}
+	}
+
+	return append(make([]T, 0, len(s.values)), s.values...)
+}
+
+// SymmetricDifference returns a new set containing the values that are in exactly one of this set or another
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	ret := s.Difference(other)
+
+	for _, v := range other.Slice() {
+		if !s.Has(v) {
+			ret.Add(v)
+		}
+	}
+
+	return ret
+}
+
+// Union returns a new set containing the values of this set followed by those of another
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	ret := s.Clone()
+	ret.Add(other.Slice()...)
+
+	return ret
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing any existing values
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	s.Clear()
+	s.Add(values...)
+
+	return nil
+}
+
+// filter returns a new set containing the values of this set that satisfy a function
+func (s *Set[T]) filter(fn func(T) bool) *Set[T] {
+	ret := NewSet[T]()
+	if s == nil {
+		return ret
+	}
+
+	for _, v := range s.values {
+		if fn(v) {
+			ret.Add(v)
+		}
+	}
+
+	return ret
+}
+
+// Add adds values to the set, ignoring any that are already present
+func (s *SyncSet[T]) Add(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Add(values...)
+}
+
+// All returns an iterator over a snapshot of the set's values, in insertion order
+// NOTE: The set may be modified during iteration without affecting the values yielded
+func (s *SyncSet[T]) All() iter.Seq[T] {
+	return s.Set().All()
+}
+
+// Clear removes all values from the set
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Clear()
+}
+
+// Has returns true if the set contains a value
+func (s *SyncSet[T]) Has(v T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Has(v)
+}
+
+// Len returns the number of values in the set
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Len()
+}
+
+// MarshalJSON encodes the set as a JSON array, in insertion order
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	return s.Set().MarshalJSON()
+}
+
+// Remove removes values from the set, ignoring any that aren't present
+func (s *SyncSet[T]) Remove(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Remove(values...)
+}
+
+// Set returns a snapshot of the set's values as a (non-concurrency-safe) Set,
+// which can be used for set algebra
+func (s *SyncSet[T]) Set() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Clone()
+}
+
+// Slice returns the set's values as a slice, in insertion order
+func (s *SyncSet[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Slice()
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing any existing values
+func (s *SyncSet[T]) UnmarshalJSON(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.UnmarshalJSON(data)
+}
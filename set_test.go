@@ -0,0 +1,201 @@
+// Tests the set.go file
+package goutils
+
+import (
+	// Standard lib
+	"encoding/json"
+	"sync"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("set.go", func() {
+	Describe("`Set` type", func() {
+		var (
+			// Sets to test against
+			a, b *Set[string]
+		)
+
+		BeforeEach(func() {
+			// Set sets
+			a = NewSet("read", "write", "read", "admin")
+			b = NewSet("write", "delete")
+		})
+
+		It("Preserves insertion order and drops duplicates", func() {
+			Expect(a.Slice()).To(Equal([]string{"read", "write", "admin"}))
+			Expect(a.Len()).To(Equal(3))
+		})
+
+		It("Adds, removes and checks for values", func() {
+			// Modify set
+			a.Add("delete", "read")
+			a.Remove("write", "missing")
+
+			// Verify set
+			Expect(a.Slice()).To(Equal([]string{"read", "admin", "delete"}))
+			Expect(a.Has("admin")).To(BeTrue())
+			Expect(a.Has("write")).To(BeFalse())
+			Expect(SliceContains("delete", a.Slice())).To(BeTrue())
+		})
+
+		It("Is usable as a zero value", func() {
+			// Create set
+			var s Set[int]
+
+			// Verify set
+			Expect(s.Has(1)).To(BeFalse())
+			s.Add(1, 2)
+			Expect(s.Slice()).To(Equal([]int{1, 2}))
+		})
+
+		It("Iterates over values in insertion order", func() {
+			// Collect values
+			actual := []string{}
+			for v := range a.All() {
+				actual = append(actual, v)
+			}
+
+			// Verify values
+			Expect(actual).To(Equal([]string{"read", "write", "admin"}))
+		})
+
+		It("Performs set algebra", func() {
+			Expect(a.Union(b).Slice()).To(Equal([]string{"read", "write", "admin", "delete"}))
+			Expect(a.Intersect(b).Slice()).To(Equal([]string{"write"}))
+			Expect(a.Difference(b).Slice()).To(Equal([]string{"read", "admin"}))
+			Expect(a.SymmetricDifference(b).Slice()).To(Equal([]string{"read", "admin", "delete"}))
+			Expect(NewSet("write").IsSubset(b)).To(BeTrue())
+			Expect(a.IsSubset(b)).To(BeFalse())
+			Expect(NewSet("delete", "write").Equal(b)).To(BeTrue())
+			Expect(a.Equal(b)).To(BeFalse())
+			Expect(a.Union(nil).Equal(a)).To(BeTrue())
+		})
+
+		It("Treats nil sets as empty sets", func() {
+			// Create nil set
+			var n *Set[string]
+
+			// Verify read-only methods
+			Expect(n.Len()).To(Equal(0))
+			Expect(n.Has("read")).To(BeFalse())
+			Expect(n.Slice()).To(BeEmpty())
+			Expect(n.Clone().Len()).To(Equal(0))
+			Expect(n.Union(a).Slice()).To(Equal([]string{"read", "write", "admin"}))
+			Expect(n.Intersect(a).Len()).To(Equal(0))
+			Expect(n.Difference(a).Len()).To(Equal(0))
+			Expect(n.SymmetricDifference(b).Slice()).To(Equal([]string{"write", "delete"}))
+			Expect(n.IsSubset(a)).To(BeTrue())
+			Expect(n.Equal(NewSet[string]())).To(BeTrue())
+
+			for range n.All() {
+				Fail("Expected no values")
+			}
+
+			data, err := json.Marshal(n)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(string(data)).To(Equal(`null`))
+
+			// Verify methods that remove values
+			n.Remove("read")
+			n.Clear()
+			Expect(n.Len()).To(Equal(0))
+		})
+
+		It("Clones and clears sets", func() {
+			// Clone and clear set
+			c := a.Clone()
+			a.Clear()
+
+			// Verify sets
+			Expect(a.Len()).To(Equal(0))
+			Expect(c.Len()).To(Equal(3))
+		})
+
+		It("Marshals to and unmarshals from JSON arrays", func() {
+			// Marshal set
+			data, err := json.Marshal(a)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(string(data)).To(Equal(`["read","write","admin"]`))
+
+			// Marshal empty set
+			data, err = json.Marshal(&Set[int]{})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(string(data)).To(Equal(`[]`))
+
+			// Marshal sets that aren't addressable
+			data, err = json.Marshal(struct{ Tags Set[string] }{Tags: *a})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(string(data)).To(Equal(`{"Tags":["read","write","admin"]}`))
+
+			data, err = json.Marshal(map[string]Set[string]{"x": *a})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(string(data)).To(Equal(`{"x":["read","write","admin"]}`))
+
+			// Unmarshal set
+			s := NewSet("foo")
+			Expect(json.Unmarshal([]byte(`["b","a","b"]`), s)).To(Succeed())
+			Expect(s.Slice()).To(Equal([]string{"b", "a"}))
+
+			// Unmarshal invalid data
+			Expect(json.Unmarshal([]byte(`{}`), s)).To(Not(Succeed()))
+		})
+	})
+
+	Describe("`SyncSet` type", func() {
+		It("Supports concurrent use", func() {
+			// Create set
+			s := NewSyncSet[int]()
+
+			// Add values concurrently
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					s.Add(i, i+1)
+					s.Has(i)
+					s.Remove(i + 1)
+				}(i * 2)
+			}
+			wg.Wait()
+
+			// Verify set
+			Expect(s.Len()).To(Equal(10))
+			Expect(s.Set().Has(18)).To(BeTrue())
+		})
+
+		It("Supports the same operations as `Set`", func() {
+			// Create set
+			s := NewSyncSet("foo", "bar")
+			s.Add("baz")
+			s.Remove("foo")
+
+			// Verify set
+			Expect(s.Slice()).To(Equal([]string{"bar", "baz"}))
+			Expect(s.Has("bar")).To(BeTrue())
+
+			// Iterate over set while modifying it
+			actual := []string{}
+			for v := range s.All() {
+				s.Add(v + "!")
+				actual = append(actual, v)
+			}
+			Expect(actual).To(Equal([]string{"bar", "baz"}))
+
+			// Marshal and unmarshal set
+			data, err := json.Marshal(s)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(string(data)).To(Equal(`["bar","baz","bar!","baz!"]`))
+			Expect(json.Unmarshal([]byte(`["qux"]`), s)).To(Succeed())
+			Expect(s.Slice()).To(Equal([]string{"qux"}))
+
+			// Clear set
+			s.Clear()
+			Expect(s.Len()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,145 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+const (
+	// setHashThreshold is the slice length above which set operations build a hash set
+	// for membership checks instead of scanning the slice
+	// NOTE: Linear scans of small slices are faster than hashing
+	setHashThreshold = 16
+)
+
+// Difference returns the values of `a` that aren't in `b`, without duplicates, in the order they were first seen
+// NOTE: O(n+m) for large inputs, O(n*m) when `b` is small enough that scanning it is faster than hashing
+func Difference[T comparable](a, b []T) []T {
+	inB := membership(b)
+
+	return uniqFunc(a, func(v T) bool {
+		return !inB(v)
+	})
+}
+
+// EqualSlices returns true if two slices contain the same values
+// NOTE: When `ignoreOrder` is true, the slices are compared as multisets (each value must appear
+// the same number of times in both). O(n) either way. Named `EqualSlices` rather than `Equal`, which
+// would clash with the dot-imported gomega matcher of the same name within this package's tests
+func EqualSlices[T comparable](a, b []T, ignoreOrder bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	if !ignoreOrder {
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// Count occurrences in `a`, then remove those found in `b`
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+
+		counts[v]--
+	}
+
+	return true
+}
+
+// Intersect returns the values of `a` that are also in `b`, without duplicates, in the order they were first seen
+// NOTE: O(n+m) for large inputs, O(n*m) when `b` is small enough that scanning it is faster than hashing
+func Intersect[T comparable](a, b []T) []T {
+	return uniqFunc(a, membership(b))
+}
+
+// IsSubset returns true if every value of `a` is also in `b`
+// NOTE: O(n+m) for large inputs, O(n*m) when `b` is small enough that scanning it is faster than hashing
+func IsSubset[T comparable](a, b []T) bool {
+	inB := membership(b)
+
+	for _, v := range a {
+		if !inB(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SymmetricDifference returns the values that are in exactly one of `a` or `b`, without duplicates,
+// with the values of `a` first, each in the order they were first seen
+// NOTE: O(n+m) for large inputs, O(n*m) when either slice is small enough that scanning it is faster than hashing
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return append(Difference(a, b), Difference(b, a)...)
+}
+
+// Union returns the values of all slices passed in, without duplicates, in the order they were first seen
+// NOTE: O(n) where n is the total length of all slices
+func Union[T comparable](slices ...[]T) []T {
+	// Determine total length to pre-size the return value
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+
+	// Form return value
+	ret := make([]T, 0, total)
+	seen := make(map[T]struct{}, total)
+
+	for _, s := range slices {
+		for _, v := range s {
+			if _, ok := seen[v]; !ok {
+				seen[v] = struct{}{}
+				ret = append(ret, v)
+			}
+		}
+	}
+
+	return ret
+}
+
+// membership returns a function that checks if a value is in a slice,
+// hashing the slice's values if it's large enough to benefit from it
+func membership[T comparable](s []T) func(T) bool {
+	if len(s) <= setHashThreshold {
+		return func(v T) bool {
+			return Contains(v, s)
+		}
+	}
+
+	m := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		m[v] = struct{}{}
+	}
+
+	return func(v T) bool {
+		_, ok := m[v]
+		return ok
+	}
+}
+
+// uniqFunc returns the values of a slice that satisfy a function, without duplicates, in the order they were first seen
+func uniqFunc[T comparable](s []T, fn func(T) bool) []T {
+	// Form return value
+	ret := make([]T, 0)
+	seen := make(map[T]struct{})
+
+	for _, v := range s {
+		if _, ok := seen[v]; ok || !fn(v) {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		ret = append(ret, v)
+	}
+
+	return ret
+}
@@ -0,0 +1,88 @@
+// Tests the setops.go file
+package goutils
+
+import (
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("setops.go", func() {
+	var (
+		// Slices to perform set operations on
+		a, b []string
+		// Large slices to ensure hashing is used
+		large1, large2 []int
+	)
+
+	BeforeEach(func() {
+		// Set slices
+		a = []string{"read", "write", "read", "admin"}
+		b = []string{"write", "delete", "write"}
+
+		// Set large slices
+		large1 = make([]int, 0, 100)
+		large2 = make([]int, 0, 100)
+		for i := 0; i < 100; i++ {
+			large1 = append(large1, i)
+			large2 = append(large2, i+50)
+		}
+	})
+
+	Describe("`Union` method", func() {
+		It("Returns all unique values in the order they were first seen", func() {
+			Expect(Union(a, b)).To(Equal([]string{"read", "write", "admin", "delete"}))
+			Expect(Union[string]()).To(BeEmpty())
+		})
+	})
+
+	Describe("`Intersect` method", func() {
+		It("Returns unique values in both slices", func() {
+			Expect(Intersect(a, b)).To(Equal([]string{"write"}))
+			Expect(Intersect(large1, large2)).To(HaveLen(50))
+			Expect(Intersect(large1, large2)[0]).To(Equal(50))
+		})
+	})
+
+	Describe("`Difference` method", func() {
+		It("Returns unique values only in the first slice", func() {
+			Expect(Difference(a, b)).To(Equal([]string{"read", "admin"}))
+			Expect(Difference(large1, large2)).To(Equal(large1[:50]))
+		})
+	})
+
+	Describe("`SymmetricDifference` method", func() {
+		It("Returns unique values in exactly one slice", func() {
+			Expect(SymmetricDifference(a, b)).To(Equal([]string{"read", "admin", "delete"}))
+			Expect(SymmetricDifference(large1, large2)).To(HaveLen(100))
+		})
+	})
+
+	Describe("`IsSubset` method", func() {
+		It("Returns a boolean indicating if every value is in the other slice", func() {
+			Expect(IsSubset([]string{"write", "write"}, b)).To(BeTrue())
+			Expect(IsSubset(a, b)).To(BeFalse())
+			Expect(IsSubset(nil, b)).To(BeTrue())
+			Expect(IsSubset(large2[:50], large1)).To(BeTrue())
+			Expect(IsSubset(large2, large1)).To(BeFalse())
+		})
+	})
+
+	Describe("`EqualSlices` method", func() {
+		Context("When order matters", func() {
+			It("Compares values position by position", func() {
+				Expect(EqualSlices([]int{1, 2, 3}, []int{1, 2, 3}, false)).To(BeTrue())
+				Expect(EqualSlices([]int{1, 2, 3}, []int{3, 2, 1}, false)).To(BeFalse())
+				Expect(EqualSlices([]int{1, 2}, []int{1, 2, 3}, false)).To(BeFalse())
+			})
+		})
+
+		Context("When order is ignored", func() {
+			It("Compares values as multisets", func() {
+				Expect(EqualSlices([]int{1, 2, 2, 3}, []int{2, 3, 2, 1}, true)).To(BeTrue())
+				Expect(EqualSlices([]int{1, 1, 2}, []int{1, 2, 2}, true)).To(BeFalse())
+				Expect(EqualSlices([]int{1, 2}, []int{1, 2, 3}, true)).To(BeFalse())
+			})
+		})
+	})
+})
@@ -1,13 +1,231 @@
 // Package goutils contains a collection of useful Golang utility methods and libraries
 package goutils
 
-// SliceContains returns true if a slice of strings includes a specific string
-func SliceContains(needle string, haystack []string) bool {
-	for _, value := range haystack {
-		if needle == value {
+import (
+	// Standard lib
+	"strings"
+)
+
+// Chunk splits a slice into chunks of (at most) `n` values, with the last chunk holding any remainder
+// NOTE: Chunks are copies and don't share the input's backing array. Returns nil if `n` is less than 1
+func Chunk[T any](s []T, n int) [][]T {
+	if n < 1 {
+		return nil
+	}
+
+	// Form return value
+	ret := make([][]T, 0, (len(s)+n-1)/n)
+
+	for i := 0; i < len(s); i += n {
+		ret = append(ret, copySlice(s[i:min(i+n, len(s))]))
+	}
+
+	return ret
+}
+
+// Compact returns the values of a slice that aren't the zero value for their type
+func Compact[T comparable](s []T) []T {
+	var zero T
+
+	return Filter(s, func(v T) bool {
+		return v != zero
+	})
+}
+
+// Contains returns true if a slice includes a specific value
+func Contains[T comparable](needle T, haystack []T) bool {
+	return IndexOf(needle, haystack) != -1
+}
+
+// ContainsAll returns true if a slice includes every one of a set of values
+// NOTE: Returns true when no values are passed in
+func ContainsAll[T comparable](needles []T, haystack []T) bool {
+	for _, needle := range needles {
+		if !Contains(needle, haystack) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainsAny returns true if a slice includes at least one of a set of values
+// NOTE: Returns false when no values are passed in
+func ContainsAny[T comparable](needles []T, haystack []T) bool {
+	for _, needle := range needles {
+		if Contains(needle, haystack) {
 			return true
 		}
 	}
 
 	return false
 }
+
+// ContainsFold returns true if a slice of strings includes a specific string,
+// ignoring differences in case (using Unicode case-folding)
+func ContainsFold(needle string, haystack []string) bool {
+	return IndexOfFold(needle, haystack) != -1
+}
+
+// ContainsFunc returns true if at least one value in a slice satisfies a function
+func ContainsFunc[T any](haystack []T, fn func(T) bool) bool {
+	return IndexFunc(haystack, fn) != -1
+}
+
+// FlattenSlices concatenates a slice of slices into a single slice
+// NOTE: See `Flatten` for flattening nested maps
+func FlattenSlices[T any](s [][]T) []T {
+	// Determine total length to pre-size the return value
+	total := 0
+	for _, child := range s {
+		total += len(child)
+	}
+
+	// Form return value
+	ret := make([]T, 0, total)
+
+	for _, child := range s {
+		ret = append(ret, child...)
+	}
+
+	return ret
+}
+
+// IndexFunc returns the index of the first value in a slice that satisfies a function, or -1 if none do
+func IndexFunc[T any](haystack []T, fn func(T) bool) int {
+	for i, value := range haystack {
+		if fn(value) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IndexOf returns the index of the first occurrence of a value in a slice, or -1 if it isn't present
+func IndexOf[T comparable](needle T, haystack []T) int {
+	for i, value := range haystack {
+		if needle == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IndexOfFold returns the index of the first occurrence of a string in a slice of strings,
+// ignoring differences in case (using Unicode case-folding), or -1 if it isn't present
+func IndexOfFold(needle string, haystack []string) int {
+	return IndexFunc(haystack, func(value string) bool {
+		return strings.EqualFold(needle, value)
+	})
+}
+
+// Interleave combines slices by taking one value from each in turn,
+// continuing with the remaining slices once shorter ones run out (ex: `[1,2,3]` and `[4]` become `[1,4,2,3]`)
+func Interleave[T any](slices ...[]T) []T {
+	// Determine total and maximum lengths
+	total, longest := 0, 0
+	for _, s := range slices {
+		total += len(s)
+		longest = max(longest, len(s))
+	}
+
+	// Form return value
+	ret := make([]T, 0, total)
+
+	for i := 0; i < longest; i++ {
+		for _, s := range slices {
+			if i < len(s) {
+				ret = append(ret, s[i])
+			}
+		}
+	}
+
+	return ret
+}
+
+// LastIndexFunc returns the index of the last value in a slice that satisfies a function, or -1 if none do
+func LastIndexFunc[T any](haystack []T, fn func(T) bool) int {
+	for i := len(haystack) - 1; i >= 0; i-- {
+		if fn(haystack[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastIndexOf returns the index of the last occurrence of a value in a slice, or -1 if it isn't present
+func LastIndexOf[T comparable](needle T, haystack []T) int {
+	for i := len(haystack) - 1; i >= 0; i-- {
+		if needle == haystack[i] {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastIndexOfFold returns the index of the last occurrence of a string in a slice of strings,
+// ignoring differences in case (using Unicode case-folding), or -1 if it isn't present
+func LastIndexOfFold(needle string, haystack []string) int {
+	return LastIndexFunc(haystack, func(value string) bool {
+		return strings.EqualFold(needle, value)
+	})
+}
+
+// SliceContains returns true if a slice of strings includes a specific string
+// NOTE: Kept for compatibility, see `Contains` for a generic version
+func SliceContains(needle string, haystack []string) bool {
+	return Contains(needle, haystack)
+}
+
+// Uniq returns the values of a slice without duplicates, in the order they were first seen
+func Uniq[T comparable](s []T) []T {
+	return UniqBy(s, func(v T) T {
+		return v
+	})
+}
+
+// UniqBy returns the values of a slice without duplicates, in the order they were first seen,
+// where two values are duplicates if a function returns the same key for both
+func UniqBy[T any, K comparable](s []T, fn func(T) K) []T {
+	// Form return value
+	ret := make([]T, 0, len(s))
+	seen := make(map[K]struct{}, len(s))
+
+	for _, v := range s {
+		k := fn(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			ret = append(ret, v)
+		}
+	}
+
+	return ret
+}
+
+// Window returns the windows of `size` consecutive values of a slice, starting every `step` values
+// (ex: a size of 3 and a step of 1 turns `[1,2,3,4]` into `[[1,2,3],[2,3,4]]`)
+// NOTE: Only full windows are returned, and windows are copies that don't share the input's backing array.
+// Returns nil if `size` or `step` are less than 1
+func Window[T any](s []T, size, step int) [][]T {
+	if size < 1 || step < 1 {
+		return nil
+	}
+
+	// Form return value
+	ret := make([][]T, 0)
+
+	for i := 0; i+size <= len(s); i += step {
+		ret = append(ret, copySlice(s[i:i+size]))
+	}
+
+	return ret
+}
+
+// copySlice returns a copy of a slice that doesn't share it's backing array
+func copySlice[T any](s []T) []T {
+	return append(make([]T, 0, len(s)), s...)
+}
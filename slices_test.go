@@ -2,6 +2,9 @@
 package goutils
 
 import (
+	// Standard lib
+	"strings"
+
 	// Third-party
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -34,4 +37,151 @@ var _ = Describe("slices.go", func() {
 			}
 		})
 	})
+
+	Describe("`Contains` method", func() {
+		It("Returns a boolean indicating if a slice contains a specific value", func() {
+			Expect(Contains(2, []int{1, 2, 3})).To(BeTrue())
+			Expect(Contains(4, []int{1, 2, 3})).To(BeFalse())
+			Expect(Contains("foo", nil)).To(BeFalse())
+		})
+	})
+
+	Describe("`ContainsAll` method", func() {
+		It("Returns a boolean indicating if a slice contains every value", func() {
+			Expect(ContainsAll([]int{1, 3}, []int{1, 2, 3})).To(BeTrue())
+			Expect(ContainsAll([]int{1, 4}, []int{1, 2, 3})).To(BeFalse())
+			Expect(ContainsAll(nil, []int{1, 2, 3})).To(BeTrue())
+		})
+	})
+
+	Describe("`ContainsAny` method", func() {
+		It("Returns a boolean indicating if a slice contains at least one value", func() {
+			Expect(ContainsAny([]int{4, 3}, []int{1, 2, 3})).To(BeTrue())
+			Expect(ContainsAny([]int{4, 5}, []int{1, 2, 3})).To(BeFalse())
+			Expect(ContainsAny(nil, []int{1, 2, 3})).To(BeFalse())
+		})
+	})
+
+	Describe("`ContainsFunc` method", func() {
+		It("Returns a boolean indicating if any value in a slice satisfies a function", func() {
+			// Create function
+			even := func(i int) bool { return i%2 == 0 }
+
+			// Verify return values
+			Expect(ContainsFunc([]int{1, 2, 3}, even)).To(BeTrue())
+			Expect(ContainsFunc([]int{1, 3}, even)).To(BeFalse())
+		})
+	})
+
+	Describe("`IndexOf` and `LastIndexOf` methods", func() {
+		It("Returns the index of the first or last occurrence of a value", func() {
+			// Create input
+			haystack := []string{"foo", "bar", "foo"}
+
+			// Verify return values
+			Expect(IndexOf("foo", haystack)).To(Equal(0))
+			Expect(LastIndexOf("foo", haystack)).To(Equal(2))
+			Expect(IndexOf("baz", haystack)).To(Equal(-1))
+			Expect(LastIndexOf("baz", haystack)).To(Equal(-1))
+		})
+	})
+
+	Describe("`IndexFunc` and `LastIndexFunc` methods", func() {
+		It("Returns the index of the first or last value satisfying a function", func() {
+			// Create function
+			even := func(i int) bool { return i%2 == 0 }
+
+			// Verify return values
+			Expect(IndexFunc([]int{1, 2, 4}, even)).To(Equal(1))
+			Expect(LastIndexFunc([]int{1, 2, 4}, even)).To(Equal(2))
+			Expect(IndexFunc([]int{1, 3}, even)).To(Equal(-1))
+			Expect(LastIndexFunc([]int{1, 3}, even)).To(Equal(-1))
+		})
+	})
+
+	Describe("Case-folding methods", func() {
+		It("Ignores differences in case", func() {
+			// Create input
+			haystack := []string{"Foo", "STRASSE", "ΣΑΣ", "foo"}
+
+			// Verify return values
+			Expect(ContainsFold("FOO", haystack)).To(BeTrue())
+			Expect(ContainsFold("σας", haystack)).To(BeTrue())
+			Expect(ContainsFold("bar", haystack)).To(BeFalse())
+			Expect(IndexOfFold("strasse", haystack)).To(Equal(1))
+			Expect(LastIndexOfFold("FOO", haystack)).To(Equal(3))
+			Expect(LastIndexOfFold("bar", haystack)).To(Equal(-1))
+		})
+	})
+
+	Describe("`Chunk` method", func() {
+		It("Splits a slice into chunks", func() {
+			Expect(Chunk([]int{1, 2, 3, 4, 5}, 2)).To(Equal([][]int{{1, 2}, {3, 4}, {5}}))
+			Expect(Chunk([]int{1, 2}, 5)).To(Equal([][]int{{1, 2}}))
+			Expect(Chunk([]int{}, 2)).To(BeEmpty())
+			Expect(Chunk([]int{1, 2}, 0)).To(BeNil())
+		})
+
+		It("Doesn't alias the input's backing array", func() {
+			// Create input and call method
+			s := []int{1, 2, 3, 4}
+			chunks := Chunk(s, 2)
+
+			// Modify chunks
+			chunks[0][0] = 100
+			chunks[0] = append(chunks[0], 200)
+
+			// Verify input is unchanged
+			Expect(s).To(Equal([]int{1, 2, 3, 4}))
+		})
+	})
+
+	Describe("`Compact` method", func() {
+		It("Removes zero values", func() {
+			Expect(Compact([]string{"", "foo", "", "bar"})).To(Equal([]string{"foo", "bar"}))
+			Expect(Compact([]int{0, 0})).To(BeEmpty())
+		})
+	})
+
+	Describe("`FlattenSlices` method", func() {
+		It("Concatenates slices", func() {
+			Expect(FlattenSlices([][]int{{1, 2}, {}, {3}})).To(Equal([]int{1, 2, 3}))
+		})
+	})
+
+	Describe("`Interleave` method", func() {
+		It("Takes one value from each slice in turn", func() {
+			Expect(Interleave([]int{1, 2, 3}, []int{4}, []int{5, 6})).To(Equal([]int{1, 4, 5, 2, 6, 3}))
+			Expect(Interleave[int]()).To(BeEmpty())
+		})
+	})
+
+	Describe("`Uniq` and `UniqBy` methods", func() {
+		It("Removes duplicates, preserving the order values were first seen", func() {
+			Expect(Uniq([]int{3, 1, 3, 2, 1})).To(Equal([]int{3, 1, 2}))
+			Expect(UniqBy([]string{"Foo", "bar", "foo", "BAR"}, strings.ToLower)).To(Equal([]string{"Foo", "bar"}))
+		})
+	})
+
+	Describe("`Window` method", func() {
+		It("Returns sliding windows of a slice", func() {
+			Expect(Window([]int{1, 2, 3, 4}, 3, 1)).To(Equal([][]int{{1, 2, 3}, {2, 3, 4}}))
+			Expect(Window([]int{1, 2, 3, 4, 5}, 2, 2)).To(Equal([][]int{{1, 2}, {3, 4}}))
+			Expect(Window([]int{1, 2}, 3, 1)).To(BeEmpty())
+			Expect(Window([]int{1, 2}, 1, 0)).To(BeNil())
+		})
+
+		It("Doesn't alias the input's backing array", func() {
+			// Create input and call method
+			s := []int{1, 2, 3}
+			windows := Window(s, 2, 1)
+
+			// Modify windows
+			windows[0][1] = 100
+
+			// Verify input and other windows are unchanged
+			Expect(s).To(Equal([]int{1, 2, 3}))
+			Expect(windows[1]).To(Equal([]int{2, 3}))
+		})
+	})
 })
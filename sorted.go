@@ -0,0 +1,191 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"cmp"
+	"container/heap"
+	"slices"
+)
+
+type (
+	// mergeHeap is a min-heap of cursors into sorted slices, used by `MergeSortedFunc`
+	mergeHeap[T any] struct {
+		cmp     func(a, b T) int // The comparator used to order values
+		cursors []mergeCursor[T] // The cursors with values remaining
+	}
+
+	// mergeCursor tracks the position within a single sorted slice being merged
+	mergeCursor[T any] struct {
+		pos    int // The position of the next value to merge
+		slice  int // The index of the slice, used to keep merging stable
+		values []T // The sorted slice
+	}
+)
+
+// CompareBy returns a comparator that orders values by the key returned by a function
+// NOTE: Useful with `SortMulti` for sorting by multiple keys
+func CompareBy[T any, K cmp.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Descending returns a comparator that reverses the order of another comparator
+func Descending[T any](c func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// MergeSorted merges multiple sorted slices into a single sorted slice
+// NOTE: O(n log k) where n is the total number of values and k is the number of slices
+func MergeSorted[T cmp.Ordered](sorted ...[]T) []T {
+	return MergeSortedFunc(cmp.Compare[T], sorted...)
+}
+
+// MergeSortedFunc merges multiple slices sorted by a comparator into a single sorted slice
+// NOTE: The merge is stable, equal values are ordered by the slice they came from
+func MergeSortedFunc[T any](c func(a, b T) int, sorted ...[]T) []T {
+	h := &mergeHeap[T]{cmp: c}
+	total := 0
+
+	for i, s := range sorted {
+		total += len(s)
+
+		if len(s) > 0 {
+			h.cursors = append(h.cursors, mergeCursor[T]{slice: i, values: s})
+		}
+	}
+
+	heap.Init(h)
+
+	// Form return value
+	ret := make([]T, 0, total)
+
+	for h.Len() > 0 {
+		// Take the smallest value, then advance or drop it's cursor
+		cursor := &h.cursors[0]
+		ret = append(ret, cursor.values[cursor.pos])
+
+		if cursor.pos++; cursor.pos < len(cursor.values) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	return ret
+}
+
+// SortBy sorts a slice in place by the key returned by a function
+// NOTE: The sort is stable, values with equal keys keep their original order
+func SortBy[T any, K cmp.Ordered](s []T, key func(T) K) {
+	slices.SortStableFunc(s, CompareBy(key))
+}
+
+// SortMulti sorts a slice in place using multiple comparators, where later comparators
+// break ties left by earlier ones (ex: by last name, then by first name)
+// NOTE: The sort is stable, values that all comparators consider equal keep their original order
+func SortMulti[T any](s []T, comparators ...func(a, b T) int) {
+	slices.SortStableFunc(s, func(a, b T) int {
+		for _, c := range comparators {
+			if n := c(a, b); n != 0 {
+				return n
+			}
+		}
+
+		return 0
+	})
+}
+
+// SortedContains returns true if a sorted slice includes a specific value
+// NOTE: O(log n) using a binary search, compared to `Contains`'s O(n) linear scan
+func SortedContains[T cmp.Ordered](needle T, haystack []T) bool {
+	_, found := slices.BinarySearch(haystack, needle)
+	return found
+}
+
+// SortedContainsFunc returns true if a slice sorted by a comparator includes a specific value
+func SortedContainsFunc[T any](needle T, haystack []T, c func(a, b T) int) bool {
+	_, found := slices.BinarySearchFunc(haystack, needle, c)
+	return found
+}
+
+// SortedInsert inserts a value into a sorted slice, keeping it sorted, and returns the updated slice
+// NOTE: Like `append`, may modify the input's backing array. The value is inserted after any equal values
+func SortedInsert[T cmp.Ordered](s []T, v T) []T {
+	return SortedInsertFunc(s, v, cmp.Compare[T])
+}
+
+// SortedInsertFunc inserts a value into a slice sorted by a comparator, keeping it sorted, and returns the updated slice
+// NOTE: Like `append`, may modify the input's backing array. The value is inserted after any equal values
+func SortedInsertFunc[T any](s []T, v T, c func(a, b T) int) []T {
+	// Find the first position after any equal values
+	i, _ := slices.BinarySearchFunc(s, v, func(e, target T) int {
+		if c(e, target) <= 0 {
+			return -1
+		}
+
+		return 1
+	})
+
+	return slices.Insert(s, i, v)
+}
+
+// SortedRemove removes all occurrences of a value from a sorted slice and returns the updated slice
+// NOTE: Like `append`, may modify the input's backing array
+func SortedRemove[T cmp.Ordered](s []T, v T) []T {
+	return SortedRemoveFunc(s, v, cmp.Compare[T])
+}
+
+// SortedRemoveFunc removes all occurrences of a value from a slice sorted by a comparator and returns the updated slice
+// NOTE: Like `append`, may modify the input's backing array
+func SortedRemoveFunc[T any](s []T, v T, c func(a, b T) int) []T {
+	start, found := slices.BinarySearchFunc(s, v, c)
+	if !found {
+		return s
+	}
+
+	// Find the end of the run of equal values
+	end := start + 1
+	for end < len(s) && c(s[end], v) == 0 {
+		end++
+	}
+
+	return slices.Delete(s, start, end)
+}
+
+// Len implements `heap.Interface`
+func (h *mergeHeap[T]) Len() int {
+	return len(h.cursors)
+}
+
+// Less implements `heap.Interface`
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+
+	if n := h.cmp(a.values[a.pos], b.values[b.pos]); n != 0 {
+		return n < 0
+	}
+
+	return a.slice < b.slice
+}
+
+// Pop implements `heap.Interface`
+func (h *mergeHeap[T]) Pop() interface{} {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+
+	return last
+}
+
+// Push implements `heap.Interface`
+func (h *mergeHeap[T]) Push(x interface{}) {
+	h.cursors = append(h.cursors, x.(mergeCursor[T]))
+}
+
+// Swap implements `heap.Interface`
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
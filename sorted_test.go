@@ -0,0 +1,131 @@
+// Tests the sorted.go file
+package goutils
+
+import (
+	// Standard lib
+	"strings"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sorted.go", func() {
+	type person struct {
+		First string
+		Last  string
+		Age   int
+	}
+
+	var (
+		// Sorted input
+		sorted []string
+		// People to sort
+		people []person
+	)
+
+	BeforeEach(func() {
+		// Set input
+		sorted = []string{"bar", "baz", "foo", "foo", "qux"}
+		people = []person{
+			{"Ann", "Smith", 30},
+			{"Bob", "Jones", 25},
+			{"Al", "Smith", 25},
+			{"Cat", "Jones", 40},
+		}
+	})
+
+	Describe("`SortedContains` method", func() {
+		It("Returns a boolean indicating if a sorted slice contains a value", func() {
+			Expect(SortedContains("foo", sorted)).To(BeTrue())
+			Expect(SortedContains("abc", sorted)).To(BeFalse())
+			Expect(SortedContains("zzz", sorted)).To(BeFalse())
+			Expect(SortedContains("foo", nil)).To(BeFalse())
+		})
+
+		It("Supports custom comparators", func() {
+			// Create slice sorted in descending order
+			desc := []int{5, 3, 1}
+			c := Descending(func(a, b int) int { return a - b })
+
+			// Verify return values
+			Expect(SortedContainsFunc(3, desc, c)).To(BeTrue())
+			Expect(SortedContainsFunc(2, desc, c)).To(BeFalse())
+		})
+	})
+
+	Describe("`SortedInsert` method", func() {
+		It("Inserts values while keeping the slice sorted", func() {
+			// Insert values
+			sorted = SortedInsert(sorted, "aaa")
+			sorted = SortedInsert(sorted, "foo")
+			sorted = SortedInsert(sorted, "zzz")
+
+			// Verify slice
+			Expect(sorted).To(Equal([]string{"aaa", "bar", "baz", "foo", "foo", "foo", "qux", "zzz"}))
+			Expect(SortedInsert(nil, 1)).To(Equal([]int{1}))
+		})
+
+		It("Inserts after equal values", func() {
+			// Insert a person equal to an existing one by age
+			byAge := CompareBy(func(p person) int { return p.Age })
+			s := []person{{"Bob", "Jones", 25}, {"Ann", "Smith", 30}}
+			s = SortedInsertFunc(s, person{"Al", "Smith", 25}, byAge)
+
+			// Verify slice
+			Expect(s[1].First).To(Equal("Al"))
+		})
+	})
+
+	Describe("`SortedRemove` method", func() {
+		It("Removes all occurrences of a value", func() {
+			Expect(SortedRemove(sorted, "foo")).To(Equal([]string{"bar", "baz", "qux"}))
+		})
+
+		It("Leaves the slice unchanged when the value isn't present", func() {
+			Expect(SortedRemove(sorted, "abc")).To(Equal([]string{"bar", "baz", "foo", "foo", "qux"}))
+		})
+	})
+
+	Describe("`MergeSorted` method", func() {
+		It("Merges sorted slices", func() {
+			Expect(MergeSorted([]int{1, 4, 7}, []int{2, 5}, nil, []int{0, 3, 6, 8})).To(Equal([]int{0, 1, 2, 3, 4, 5, 6, 7, 8}))
+			Expect(MergeSorted[int]()).To(BeEmpty())
+		})
+
+		It("Keeps equal values in the order of their slices", func() {
+			// Call method
+			actual := MergeSortedFunc(CompareBy(func(p person) int { return p.Age }),
+				[]person{{"Bob", "Jones", 25}, {"Ann", "Smith", 30}},
+				[]person{{"Al", "Smith", 25}, {"Cat", "Jones", 40}},
+			)
+
+			// Verify return value
+			Expect(Map(actual, func(p person) string { return p.First })).To(Equal([]string{"Bob", "Al", "Ann", "Cat"}))
+		})
+	})
+
+	Describe("`SortBy` method", func() {
+		It("Stably sorts a slice by a key", func() {
+			// Call method
+			SortBy(people, func(p person) int { return p.Age })
+
+			// Verify slice
+			Expect(Map(people, func(p person) string { return p.First })).To(Equal([]string{"Bob", "Al", "Ann", "Cat"}))
+		})
+	})
+
+	Describe("`SortMulti` method", func() {
+		It("Sorts a slice by multiple comparators", func() {
+			// Call method
+			SortMulti(people,
+				CompareBy(func(p person) string { return p.Last }),
+				Descending(CompareBy(func(p person) int { return p.Age })),
+				CompareBy(func(p person) string { return strings.ToLower(p.First) }),
+			)
+
+			// Verify slice
+			Expect(Map(people, func(p person) string { return p.First })).To(Equal([]string{"Cat", "Bob", "Ann", "Al"}))
+		})
+	})
+})
@@ -0,0 +1,381 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"encoding"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+var (
+	// textMarshalerType is used to detect values that know how to represent themselves as text
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+type (
+	// structField represents a struct field that's converted to a map entry
+	structField struct {
+		index     []int        // The index sequence used to read the field through any embedded structs
+		name      string       // The field's name within the map
+		omitEmpty bool         // Whether the field is skipped when empty
+		tagged    bool         // Whether the field's name came from a json tag
+		typ       reflect.Type // The field's type
+	}
+)
+
+// Struct2Map converts a struct (or a pointer to one) to a `map[string]interface{}`
+// NOTE: Follows the same rules as `encoding/json` for naming fields: json tags are honored
+// (including "-" and omitempty), unexported fields are skipped and fields of embedded structs
+// are promoted into the parent map (see `structFields` for how conflicting names are resolved).
+// Nested structs are converted to nested maps, byte slices are converted to base64 strings and
+// values implementing `encoding.TextMarshaler` (like `time.Time`) are converted to strings
+func Struct2Map(i interface{}) (map[string]interface{}, error) {
+	v := reflect.ValueOf(i)
+
+	// Dereference pointers
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("Unable to convert a nil pointer to a map")
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("Unable to convert a value of type %T to a map", i)
+	}
+
+	return struct2Map(v)
+}
+
+// Struct2Values converts a struct (or a pointer to one) to a set of URL values,
+// suitable for use as a request's query string
+// NOTE: See `Struct2Map` and `Map2Values` for conversion rules
+func Struct2Values(i interface{}) (url.Values, error) {
+	m, err := Struct2Map(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return Map2Values(m), nil
+}
+
+// Map2FlatMap converts a nested map into a single-level map with dot-notation keys,
+// where slice elements are keyed by their index (ex: `{"a":{"b":[1,2]}}` becomes `{"a.b.0":1,"a.b.1":2}`)
+// NOTE: Shorthand for calling `Flatten` with the default config
+func Map2FlatMap(m map[string]interface{}) map[string]interface{} {
+	return Flatten(m, nil)
+}
+
+// Map2Values converts a nested map into a set of URL values
+// NOTE: Nested map keys are joined with dots, slices of scalar values are added
+// as repeated values for the same key, and nil values are skipped
+func Map2Values(m map[string]interface{}) url.Values {
+	// Form return value
+	ret := make(url.Values, len(m))
+
+	for k, v := range m {
+		addValues(ret, k, v)
+	}
+
+	return ret
+}
+
+// addValues adds a single value (which may be a map or slice) to a set of URL values
+func addValues(values url.Values, key string, v interface{}) {
+	switch t := v.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		for k, child := range t {
+			addValues(values, key+"."+k, child)
+		}
+	case []interface{}:
+		for n, child := range t {
+			// Nested collections are keyed by their index, scalars are repeated
+			switch child.(type) {
+			case map[string]interface{}, []interface{}:
+				addValues(values, key+"."+Int2String(n), child)
+			default:
+				addValues(values, key, child)
+			}
+		}
+	default:
+		values.Add(key, scalar2String(t))
+	}
+}
+
+// isEmptyValue determines if a value is considered empty for the purposes of omitempty
+// NOTE: Matches the definition used by `encoding/json`
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// parseJSONTag splits a json struct tag into it's name and whether omitempty was set
+func parseJSONTag(tag string) (string, bool) {
+	parts := strings.Split(tag, ",")
+
+	return parts[0], SliceContains("omitempty", parts[1:])
+}
+
+// reflectValue2Interface converts a reflected value into a plain value,
+// converting structs, maps and slices into their generic counterparts
+func reflectValue2Interface(v reflect.Value) (interface{}, error) {
+	// Prefer a value's own text representation when it has one
+	if v.Type().Implements(textMarshalerType) && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, err
+		}
+
+		return string(b), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return reflectValue2Interface(v.Elem())
+	case reflect.Struct:
+		return struct2Map(v)
+	case reflect.Map:
+		// Only maps keyed by strings can be converted
+		if v.Type().Key().Kind() != reflect.String {
+			return v.Interface(), nil
+		}
+
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		ret := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			child, err := reflectValue2Interface(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+
+			ret[iter.Key().String()] = child
+		}
+
+		return ret, nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil, nil
+		}
+
+		// Encode byte slices as base64 strings
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+		}
+
+		ret := make([]interface{}, v.Len())
+		for n := 0; n < v.Len(); n++ {
+			child, err := reflectValue2Interface(v.Index(n))
+			if err != nil {
+				return nil, err
+			}
+
+			ret[n] = child
+		}
+
+		return ret, nil
+	default:
+		return v.Interface(), nil
+	}
+}
+
+// scalar2String converts a scalar value to a string
+// NOTE: Unlike `Interface2String`, falls back to the value's default format for unsupported types
+func scalar2String(v interface{}) string {
+	switch t := v.(type) {
+	case bool:
+		return Bool2String(t)
+	case float64, int, int64, string:
+		return Interface2String(t)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+// struct2Map converts a reflected struct value to a `map[string]interface{}`
+func struct2Map(v reflect.Value) (map[string]interface{}, error) {
+	fields := structFields(v.Type())
+
+	// Form return value
+	ret := make(map[string]interface{}, len(fields))
+
+	for _, field := range fields {
+		// Read promoted fields through their embedded structs, skipping fields of nil embedded pointers
+		fv, err := v.FieldByIndexErr(field.index)
+		if err != nil {
+			continue
+		}
+
+		// Skip empty values when requested
+		if field.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+
+		child, err := reflectValue2Interface(fv)
+		if err != nil {
+			return nil, err
+		}
+
+		ret[field.name] = child
+	}
+
+	return ret, nil
+}
+
+// structFields returns the fields of a struct type that should be converted to map entries
+// NOTE: Matches the rules used by `encoding/json`: fields of untagged embedded structs are promoted
+// (including exported fields of unexported embedded struct types), shallower fields take precedence
+// over deeper ones, tagged fields take precedence over untagged ones at the same depth, and
+// any remaining conflicts cause all of the conflicting fields to be dropped
+func structFields(t reflect.Type) []structField {
+	var (
+		// Fields found so far, in the order they were found
+		fields []structField
+		// Struct types to visit at the current and next depths
+		current, next []structField
+		// Number of times each struct type was found at the current and next depths
+		count, nextCount map[reflect.Type]int
+		// Struct types already visited, to avoid cycles through embedded pointers
+		visited = map[reflect.Type]bool{}
+	)
+
+	next = []structField{{typ: t}}
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[reflect.Type]int{}
+
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+
+			visited[f.typ] = true
+
+			for n := 0; n < f.typ.NumField(); n++ {
+				sf := f.typ.Field(n)
+
+				if sf.Anonymous {
+					ft := sf.Type
+					if ft.Kind() == reflect.Ptr {
+						ft = ft.Elem()
+					}
+
+					// Skip embedded fields of unexported non-struct types,
+					// and embedded pointers to unexported struct types
+					if !sf.IsExported() && (ft.Kind() != reflect.Struct || sf.Type.Kind() == reflect.Ptr) {
+						continue
+					}
+				} else if !sf.IsExported() {
+					// Skip unexported fields
+					continue
+				}
+
+				name, omitEmpty := parseJSONTag(sf.Tag.Get("json"))
+
+				// Skip ignored fields
+				if name == "-" {
+					continue
+				}
+
+				index := make([]int, len(f.index)+1)
+				copy(index, f.index)
+				index[len(f.index)] = n
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+
+				// Record named fields, and fields that aren't untagged embedded structs
+				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					tagged := name != ""
+					if name == "" {
+						name = sf.Name
+					}
+
+					field := structField{index: index, name: name, omitEmpty: omitEmpty, tagged: tagged, typ: ft}
+					fields = append(fields, field)
+
+					// Record a duplicate when the struct containing the field was found more than once
+					// at this depth, so the conflict drops both
+					if count[f.typ] > 1 {
+						fields = append(fields, field)
+					}
+
+					continue
+				}
+
+				// Visit the embedded struct's fields at the next depth
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, structField{index: index, name: ft.Name(), typ: ft})
+				}
+			}
+		}
+	}
+
+	// Keep the dominant field for each name, dropping names with conflicting fields
+	ret := make([]structField, 0, len(fields))
+	for _, field := range fields {
+		if dominant, ok := dominantField(field.name, fields); ok && reflect.DeepEqual(dominant.index, field.index) {
+			ret = append(ret, field)
+		}
+	}
+
+	return ret
+}
+
+// dominantField returns the field that takes precedence among the fields with a given name,
+// or false if no field does
+func dominantField(name string, fields []structField) (structField, bool) {
+	var (
+		// The dominant field found so far
+		dominant structField
+		// The number of fields at the dominant field's depth and tag precedence
+		n int
+	)
+
+	for _, f := range fields {
+		if f.name != name {
+			continue
+		}
+
+		switch {
+		case n == 0, len(f.index) < len(dominant.index), len(f.index) == len(dominant.index) && f.tagged && !dominant.tagged:
+			dominant, n = f, 1
+		case len(f.index) == len(dominant.index) && f.tagged == dominant.tagged:
+			n++
+		}
+	}
+
+	return dominant, n == 1
+}
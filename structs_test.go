@@ -0,0 +1,184 @@
+// Tests the structs.go file
+package goutils
+
+import (
+	// Standard lib
+	"net/url"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("structs.go", func() {
+	var (
+		// Time to use within test data
+		created = time.Date(2017, 9, 19, 12, 0, 0, 0, time.UTC)
+	)
+
+	Describe("`Struct2Map` method", func() {
+		var (
+			// Input for `Struct2Map` input
+			input *Struct2MapTestData
+		)
+
+		BeforeEach(func() {
+			// Set input
+			input = &Struct2MapTestData{
+				Struct2MapEmbeddedTestData: Struct2MapEmbeddedTestData{ID: 1, Name: "embedded"},
+				Name:                       "foo",
+				Tags:                       []string{"bar", "baz"},
+				Created:                    created,
+				Ignored:                    "ignored",
+				Untagged:                   true,
+				private:                    "private",
+			}
+		})
+
+		Context("When the input isn't a struct", func() {
+			It("Returns an error", func() {
+				// Call method
+				_, err := Struct2Map("foo")
+
+				// Verify return value
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("When the input is a nil pointer", func() {
+			It("Returns an error", func() {
+				// Call method
+				_, err := Struct2Map((*Struct2MapTestData)(nil))
+
+				// Verify return value
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("When the input is a struct", func() {
+			It("Honors json tags and promotes embedded fields", func() {
+				// Call method
+				actual, err := Struct2Map(input)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal(map[string]interface{}{
+					"id":       1,
+					"name":     "foo",
+					"tags":     []interface{}{"bar", "baz"},
+					"created":  "2017-09-19T12:00:00Z",
+					"Untagged": true,
+				}))
+			})
+
+			It("Converts nested structs and embedded pointers to maps", func() {
+				// Set nested values
+				input.Struct2MapEmbeddedPtrTestData = &Struct2MapEmbeddedPtrTestData{Page: 2}
+				input.Nested = &Struct2MapNested{Value: 1.5}
+				input.Count = 3
+
+				// Call method
+				actual, err := Struct2Map(*input)
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(HaveKeyWithValue("page", 2))
+				Expect(actual).To(HaveKeyWithValue("count", 3))
+				Expect(actual).To(HaveKeyWithValue("nested", map[string]interface{}{"value": 1.5}))
+			})
+
+			It("Resolves promoted fields like `encoding/json`", func() {
+				// Call method
+				actual, err := Struct2Map(&Struct2MapConflictTestData{
+					struct2MapUnexportedTestData: struct2MapUnexportedTestData{Label: "foo", Title: "foo", Token: "foo"},
+					Struct2MapLabelTestData:      Struct2MapLabelTestData{Label: "bar", Title: "bar"},
+					Bytes:                        []byte("hi"),
+				})
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual).To(Equal(map[string]interface{}{
+					"bytes": "aGk=",
+					"Title": "bar",
+					"token": "foo",
+				}))
+			})
+		})
+	})
+
+	Describe("`Struct2Values` method", func() {
+		Context("When the input isn't a struct", func() {
+			It("Returns an error", func() {
+				// Call method
+				_, err := Struct2Values(1234)
+
+				// Verify return value
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("When the input is a struct", func() {
+			It("Encodes byte slices as base64", func() {
+				// Call method
+				actual, err := Struct2Values(&Struct2MapConflictTestData{Bytes: []byte("hi")})
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual.Get("bytes")).To(Equal("aGk="))
+			})
+
+			It("Returns URL values", func() {
+				// Call method
+				actual, err := Struct2Values(&Struct2MapTestData{
+					Name:    "foo",
+					Tags:    []string{"bar", "baz"},
+					Created: created,
+					Nested:  &Struct2MapNested{Value: 1.5},
+				})
+
+				// Verify return value
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(actual.Encode()).To(Equal("Untagged=false&created=2017-09-19T12%3A00%3A00Z&id=0&name=foo&nested.value=1.5&tags=bar&tags=baz"))
+			})
+		})
+	})
+
+	Describe("`Map2FlatMap` method", func() {
+		It("Flattens a nested map using dot-notation keys", func() {
+			// Call method
+			actual := Map2FlatMap(map[string]interface{}{
+				"a": map[string]interface{}{"b": []interface{}{1, 2}},
+				"c": "foo",
+			})
+
+			// Verify return value
+			Expect(actual).To(Equal(map[string]interface{}{
+				"a.b.0": 1,
+				"a.b.1": 2,
+				"c":     "foo",
+			}))
+		})
+	})
+
+	Describe("`Map2Values` method", func() {
+		It("Converts a nested map to URL values", func() {
+			// Call method
+			actual := Map2Values(map[string]interface{}{
+				"a":    map[string]interface{}{"b": []interface{}{1, 2}},
+				"c":    []interface{}{map[string]interface{}{"d": true}},
+				"e":    nil,
+				"f":    uint(3),
+				"name": "foo",
+			})
+
+			// Verify return value
+			Expect(actual).To(Equal(url.Values{
+				"a.b":   []string{"1", "2"},
+				"c.0.d": []string{"true"},
+				"f":     []string{"3"},
+				"name":  []string{"foo"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,176 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// TimeoutPhaseDial is the phase of a request spent resolving the host and opening a connection
+	TimeoutPhaseDial TimeoutPhase = "dial"
+	// TimeoutPhaseTLSHandshake is the phase of a request spent on the TLS handshake
+	TimeoutPhaseTLSHandshake TimeoutPhase = "TLS handshake"
+	// TimeoutPhaseResponseHeader is the phase of a request spent sending it and waiting for the response's headers
+	TimeoutPhaseResponseHeader TimeoutPhase = "response header"
+	// TimeoutPhaseBodyRead is the phase of a request spent reading the response's body
+	TimeoutPhaseBodyRead TimeoutPhase = "body read"
+)
+
+type (
+	// TimeoutPhase represents a phase of an HTTP request that can time out
+	TimeoutPhase string
+
+	// TimeoutConfig contains a set of timeouts for the phases of an HTTP request,
+	// where a zero value means the phase isn't bounded separately
+	// NOTE: The overall and body read timeouts apply to each attempt made with any client. The dial, TLS handshake
+	// and response header timeouts are set on the transport, so they only apply when a config doesn't set it's own `Client`
+	TimeoutConfig struct {
+		BodyRead       time.Duration // The maximum time spent reading the response's body, once it's headers are received
+		Dial           time.Duration // The maximum time spent resolving the host and opening a connection
+		Overall        time.Duration // The maximum time spent on the whole request, including reading the response's body. Takes precedence over `RequestConfig.Timeout`
+		ResponseHeader time.Duration // The maximum time spent waiting for the response's headers, once the request is sent
+		TLSHandshake   time.Duration // The maximum time spent on the TLS handshake
+	}
+
+	// TimeoutError represents an HTTP request that timed out, along with the phase it timed out in
+	TimeoutError struct {
+		Err   error        // The underlying error
+		Phase TimeoutPhase // The phase of the request that was in progress
+	}
+
+	// phaseTracker tracks the phase of a request using an `httptrace.ClientTrace`
+	phaseTracker struct {
+		phase atomic.Value // The current phase of the request
+	}
+
+	// timeoutBody is a response body that times out reads after a deadline,
+	// and releases the request's context once closed
+	timeoutBody struct {
+		io.ReadCloser
+
+		cancel context.CancelFunc // Cancels the request's context
+		fired  atomic.Bool        // Whether the deadline has passed
+		timer  *time.Timer        // Fires once the deadline passes, if there is one
+	}
+)
+
+// NewTimeoutConfig returns a TimeoutConfig struct with
+// default settings set for each of it's properties
+func NewTimeoutConfig() *TimeoutConfig {
+	return &TimeoutConfig{
+		BodyRead:       0,
+		Dial:           0,
+		Overall:        0,
+		ResponseHeader: 0,
+		TLSHandshake:   0,
+	}
+}
+
+// Error implements the `error` interface
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("Request timed out during %s: %s", e.Phase, e.Err.Error())
+}
+
+// Timeout returns true, implementing `net.Error`
+func (e *TimeoutError) Timeout() bool {
+	return true
+}
+
+// Temporary returns true, implementing `net.Error`
+func (e *TimeoutError) Temporary() bool {
+	return true
+}
+
+// Unwrap returns the underlying error, for use with `errors.Is` and `errors.As`
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// wrap wraps timeout errors in a TimeoutError describing the phase the request was in
+func (p *phaseTracker) wrap(err error) error {
+	if !isTimeout(err) || errors.As(err, new(*TimeoutError)) {
+		return err
+	}
+
+	return &TimeoutError{Err: err, Phase: p.phase.Load().(TimeoutPhase)}
+}
+
+// Close stops the deadline and closes the body
+func (b *timeoutBody) Close() error {
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
+
+// Read reads from the body, returning a TimeoutError once the deadline (or the request's overall deadline) passes
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && (b.fired.Load() || isTimeout(err)) && !errors.As(err, new(*TimeoutError)) {
+		err = &TimeoutError{Err: err, Phase: TimeoutPhaseBodyRead}
+	}
+
+	return n, err
+}
+
+// isTimeout returns true if an error was caused by a timeout or deadline
+func isTimeout(err error) bool {
+	var ne net.Error
+
+	return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &ne) && ne.Timeout())
+}
+
+// newPhaseTracker returns a phaseTracker, along with a context that traces a request's phases using it
+func newPhaseTracker(ctx context.Context) (*phaseTracker, context.Context) {
+	p := &phaseTracker{}
+	p.phase.Store(TimeoutPhaseDial)
+
+	set := func(phase TimeoutPhase) {
+		p.phase.Store(phase)
+	}
+
+	return p, httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(httptrace.GotConnInfo) {
+			set(TimeoutPhaseResponseHeader)
+		},
+		GotFirstResponseByte: func() {
+			set(TimeoutPhaseBodyRead)
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			if err == nil {
+				set(TimeoutPhaseResponseHeader)
+			}
+		},
+		TLSHandshakeStart: func() {
+			set(TimeoutPhaseTLSHandshake)
+		},
+	})
+}
+
+// newTimeoutBody wraps a response body so reads time out after a duration (if greater than 0),
+// cancelling the request's context when they do
+func newTimeoutBody(body io.ReadCloser, d time.Duration, cancel context.CancelFunc) *timeoutBody {
+	b := &timeoutBody{ReadCloser: body, cancel: cancel}
+
+	if d > 0 {
+		b.timer = time.AfterFunc(d, func() {
+			b.fired.Store(true)
+			cancel()
+		})
+	}
+
+	return b
+}
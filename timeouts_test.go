@@ -0,0 +1,245 @@
+// Tests the timeouts.go file
+package goutils
+
+import (
+	// Standard lib
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("timeouts.go", func() {
+	// expectTimeout verifies an error is a TimeoutError for a phase
+	expectTimeout := func(err error, phase TimeoutPhase) {
+		timeoutErr := &TimeoutError{}
+		Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+		Expect(timeoutErr.Phase).To(Equal(phase))
+		Expect(timeoutErr.Timeout()).To(BeTrue())
+	}
+
+	Describe("`NewTimeoutConfig` method", func() {
+		It("Returns a valid timeout config struct", func() {
+			// Call method
+			c := NewTimeoutConfig()
+
+			// Verify timeout config was properly created and returned
+			Expect(c.BodyRead).To(BeZero())
+			Expect(c.Dial).To(BeZero())
+			Expect(c.Overall).To(BeZero())
+			Expect(c.ResponseHeader).To(BeZero())
+			Expect(c.TLSHandshake).To(BeZero())
+		})
+	})
+
+	Describe("`TimeoutError` type", func() {
+		It("Describes the phase that timed out", func() {
+			// Create error
+			err := &TimeoutError{Err: context.DeadlineExceeded, Phase: TimeoutPhaseDial}
+
+			// Verify return values
+			Expect(err.Error()).To(Equal("Request timed out during dial: context deadline exceeded"))
+			Expect(err.Temporary()).To(BeTrue())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
+
+	Describe("Making requests with timeouts", func() {
+		It("Uses sub-second overall timeouts", func() {
+			// Call method
+			start := time.Now()
+			_, err := GetStatusCodeForRequest(&RequestConfig{
+				Method:   "GET",
+				Timeout:  5,
+				Timeouts: &TimeoutConfig{Overall: 100 * time.Millisecond},
+				URL:      getMockServer("timeout").URL,
+			})
+
+			// Verify return value
+			expectTimeout(err, TimeoutPhaseResponseHeader)
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+
+		It("Uses overall timeouts longer than the config's timeout", func() {
+			// Create test server that responds after the config's timeout
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(1500 * time.Millisecond)
+			}))
+			defer server.Close()
+
+			// Call method
+			code, err := GetStatusCodeForRequest(&RequestConfig{
+				Method:   "GET",
+				Timeout:  1,
+				Timeouts: &TimeoutConfig{Overall: 3 * time.Second},
+				URL:      server.URL,
+			})
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+		})
+
+		It("Applies the overall timeout to any client", func() {
+			// Call method with a client without a timeout
+			_, err := GetStatusCodeForRequest(&RequestConfig{
+				Client:   &http.Client{},
+				Method:   "GET",
+				Timeouts: &TimeoutConfig{Overall: 100 * time.Millisecond},
+				URL:      getMockServer("timeout").URL,
+			})
+
+			// Verify return value
+			expectTimeout(err, TimeoutPhaseResponseHeader)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+
+		It("Applies the overall timeout to reading the body", func() {
+			// Make request
+			res, err := MakeRequest(&RequestConfig{
+				Client:   &http.Client{},
+				Method:   "GET",
+				Timeouts: &TimeoutConfig{Overall: 100 * time.Millisecond},
+				URL:      getMockServer("slow-body").URL,
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			defer res.Body.Close()
+
+			// Read body
+			_, err = io.ReadAll(res.Body)
+
+			// Verify return value
+			expectTimeout(err, TimeoutPhaseBodyRead)
+		})
+
+		It("Times out waiting for response headers", func() {
+			// Call method
+			_, err := GetStatusCodeForRequest(&RequestConfig{
+				Method:   "GET",
+				Timeout:  5,
+				Timeouts: &TimeoutConfig{ResponseHeader: 100 * time.Millisecond},
+				URL:      getMockServer("timeout").URL,
+			})
+
+			// Verify return value
+			expectTimeout(err, TimeoutPhaseResponseHeader)
+		})
+
+		It("Times out dialing", func() {
+			// Create client that never connects
+			client := &http.Client{
+				Timeout: 50 * time.Millisecond,
+				Transport: &http.Transport{
+					DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+						<-ctx.Done()
+						return nil, ctx.Err()
+					},
+				},
+			}
+
+			// Call method
+			_, err := GetStatusCodeForRequest(&RequestConfig{Client: client, Method: "GET", URL: "http://example.com"})
+
+			// Verify return value
+			expectTimeout(err, TimeoutPhaseDial)
+		})
+
+		It("Times out during the TLS handshake", func() {
+			// Create listener that never completes a handshake
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).To(Not(HaveOccurred()))
+			defer l.Close()
+
+			go func() {
+				for {
+					conn, err := l.Accept()
+					if err != nil {
+						return
+					}
+
+					defer conn.Close()
+				}
+			}()
+
+			// Call method
+			_, err = GetStatusCodeForRequest(&RequestConfig{
+				Method:   "GET",
+				Timeout:  5,
+				Timeouts: &TimeoutConfig{TLSHandshake: 50 * time.Millisecond},
+				URL:      "https://" + l.Addr().String(),
+			})
+
+			// Verify return value
+			expectTimeout(err, TimeoutPhaseTLSHandshake)
+		})
+
+		It("Times out reading the body", func() {
+			// Make request
+			res, err := MakeRequest(&RequestConfig{
+				Method:   "GET",
+				Timeout:  5,
+				Timeouts: &TimeoutConfig{BodyRead: 50 * time.Millisecond},
+				URL:      getMockServer("slow-body").URL,
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			defer res.Body.Close()
+
+			// Read body
+			_, err = io.ReadAll(res.Body)
+
+			// Verify return value
+			expectTimeout(err, TimeoutPhaseBodyRead)
+		})
+
+		It("Reads bodies within the body read timeout", func() {
+			// Make request
+			res, err := MakeRequest(&RequestConfig{
+				Method:   "GET",
+				Timeouts: &TimeoutConfig{BodyRead: time.Second},
+				URL:      getMockServer("default").URL,
+			})
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Read body
+			body, err := io.ReadAll(res.Body)
+
+			// Verify return values
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(string(body)).To(Equal("{}\n"))
+			Expect(res.Body.Close()).To(Succeed())
+		})
+
+		It("Sets phase timeouts on the shared client's transport", func() {
+			// Get client
+			c := &RequestConfig{Timeouts: &TimeoutConfig{
+				Dial:           time.Second,
+				ResponseHeader: 2 * time.Second,
+				TLSHandshake:   3 * time.Second,
+			}}
+			client, err := c.client()
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Verify client's settings
+			t := client.Transport.(*http.Transport)
+			Expect(t.DialContext).To(Not(BeNil()))
+			Expect(t.ResponseHeaderTimeout).To(Equal(2 * time.Second))
+			Expect(t.TLSHandshakeTimeout).To(Equal(3 * time.Second))
+		})
+
+		It("Doesn't wrap other errors", func() {
+			// Call method
+			_, err := GetStatusCodeForRequest(&RequestConfig{Method: "GET", URL: "http://invalid-host.invalid"})
+
+			// Verify return value
+			Expect(err).To(HaveOccurred())
+			Expect(errors.As(err, new(*TimeoutError))).To(BeFalse())
+		})
+	})
+})
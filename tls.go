@@ -0,0 +1,176 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+type (
+	// TLSConfig contains a set of TLS settings to be used when making HTTPS requests,
+	// such as custom CA bundles and client certificates for mutual TLS
+	// NOTE: PEM settings may be used instead of (or alongside) their file counterparts. Files are checked
+	// for changes before each request made without a `Client`, and the pooled transport using them is
+	// replaced when they change, so rotated CA bundles and client certificates are picked up
+	TLSConfig struct {
+		CAFile             string // The path of a PEM encoded CA bundle used to verify servers, if any
+		CAPEM              []byte // A PEM encoded CA bundle used to verify servers, if any
+		CertFile           string // The path of a PEM encoded client certificate, if any
+		CertPEM            []byte // A PEM encoded client certificate, if any
+		InsecureSkipVerify bool   // Whether to skip verifying the server's certificate. Only meant for tests
+		KeyFile            string // The path of the PEM encoded private key of the client certificate, if any
+		KeyPEM             []byte // The PEM encoded private key of the client certificate, if any
+		MinVersion         uint16 // The minimum TLS version to use (ex: `tls.VersionTLS12`)
+		ServerName         string // Overrides the host name used to verify the server's certificate, if set
+	}
+
+	// tlsFileTimes contains the modification times of the files of a TLSConfig, used to detect rotated files
+	tlsFileTimes struct {
+		ca   int64 // The modification time of the CA bundle file, in nanoseconds
+		cert int64 // The modification time of the client certificate file, in nanoseconds
+		key  int64 // The modification time of the private key file, in nanoseconds
+	}
+
+	// tlsKey is a comparable representation of a TLSConfig, used to pool transports
+	// NOTE: Files are compared by path, see `tlsFileTimes` for detecting changes to their contents
+	tlsKey struct {
+		caFile             string
+		caPEM              string
+		certFile           string
+		certPEM            string
+		insecureSkipVerify bool
+		keyFile            string
+		keyPEM             string
+		minVersion         uint16
+		serverName         string
+	}
+)
+
+// NewTLSConfig returns a TLSConfig struct with
+// default settings set for each of it's properties
+func NewTLSConfig() *TLSConfig {
+	return &TLSConfig{
+		CAFile:             "",
+		CAPEM:              nil,
+		CertFile:           "",
+		CertPEM:            nil,
+		InsecureSkipVerify: false,
+		KeyFile:            "",
+		KeyPEM:             nil,
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         "",
+	}
+}
+
+// Build returns a `tls.Config` using the config's settings, loading any files it references
+// NOTE: When a CA bundle is set, only it's certificates are trusted, instead of the system's
+func (c *TLSConfig) Build() (*tls.Config, error) {
+	cfg := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		MinVersion:         c.MinVersion,
+		ServerName:         c.ServerName,
+	}
+
+	// Load CA bundle
+	if c.CAFile != "" || len(c.CAPEM) != 0 {
+		pool := x509.NewCertPool()
+
+		bundles := [][]byte{c.CAPEM}
+		if c.CAFile != "" {
+			b, err := os.ReadFile(c.CAFile)
+			if err != nil {
+				return nil, err
+			}
+
+			bundles = append(bundles, b)
+		}
+
+		for _, b := range bundles {
+			if len(b) != 0 && !pool.AppendCertsFromPEM(b) {
+				return nil, fmt.Errorf("No certificates were found in the CA bundle")
+			}
+		}
+
+		cfg.RootCAs = pool
+	}
+
+	// Load client certificate
+	switch {
+	case c.CertFile != "" || c.KeyFile != "":
+		if c.CertFile == "" || c.KeyFile == "" {
+			return nil, fmt.Errorf("Both a client certificate file and key file must be set")
+		}
+
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	case len(c.CertPEM) != 0 || len(c.KeyPEM) != 0:
+		if len(c.CertPEM) == 0 || len(c.KeyPEM) == 0 {
+			return nil, fmt.Errorf("Both a client certificate and key must be set")
+		}
+
+		cert, err := tls.X509KeyPair(c.CertPEM, c.KeyPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// fileTimes returns the modification times of the config's files
+// NOTE: Safe to call on a nil config
+func (c *TLSConfig) fileTimes() tlsFileTimes {
+	if c == nil {
+		return tlsFileTimes{}
+	}
+
+	return tlsFileTimes{
+		ca:   modTime(c.CAFile),
+		cert: modTime(c.CertFile),
+		key:  modTime(c.KeyFile),
+	}
+}
+
+// key returns a comparable representation of the config
+// NOTE: Safe to call on a nil config
+func (c *TLSConfig) key() tlsKey {
+	if c == nil {
+		return tlsKey{}
+	}
+
+	return tlsKey{
+		caFile:             c.CAFile,
+		caPEM:              string(c.CAPEM),
+		certFile:           c.CertFile,
+		certPEM:            string(c.CertPEM),
+		insecureSkipVerify: c.InsecureSkipVerify,
+		keyFile:            c.KeyFile,
+		keyPEM:             string(c.KeyPEM),
+		minVersion:         c.MinVersion,
+		serverName:         c.ServerName,
+	}
+}
+
+// modTime returns the modification time of a file in nanoseconds, or 0 if it isn't set or can't be read
+// NOTE: Errors reading files are returned when the config is built
+func modTime(path string) int64 {
+	if path == "" {
+		return 0
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+
+	return info.ModTime().UnixNano()
+}
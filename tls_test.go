@@ -0,0 +1,192 @@
+// Tests the tls.go file
+// NOTE: Uses an external test package, as the `goutilstest` helpers import this package
+package goutils_test
+
+import (
+	// Standard lib
+	"crypto/tls"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	// Local
+	goutils "github.com/marksost/go-utils"
+	"github.com/marksost/go-utils/goutilstest"
+)
+
+var _ = Describe("tls.go", func() {
+	var (
+		// Test server whose certificates are used as test data
+		server *goutilstest.TLSTestServer
+	)
+
+	BeforeEach(func() {
+		// Set server
+		var err error
+		server, err = goutilstest.NewTLSTestServer(nil, false)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("`NewTLSConfig` method", func() {
+		It("Returns a valid TLS config struct", func() {
+			// Call method
+			c := goutils.NewTLSConfig()
+
+			// Verify TLS config was properly created and returned
+			Expect(c.CAFile).To(Equal(""))
+			Expect(c.CAPEM).To(BeNil())
+			Expect(c.CertFile).To(Equal(""))
+			Expect(c.CertPEM).To(BeNil())
+			Expect(c.InsecureSkipVerify).To(BeFalse())
+			Expect(c.KeyFile).To(Equal(""))
+			Expect(c.KeyPEM).To(BeNil())
+			Expect(c.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+			Expect(c.ServerName).To(Equal(""))
+		})
+	})
+
+	Describe("`Build` method", func() {
+		Context("The config is valid", func() {
+			It("Builds a TLS config from PEM settings", func() {
+				// Call method
+				c := server.TLSConfig()
+				c.InsecureSkipVerify = true
+				c.MinVersion = tls.VersionTLS13
+				c.ServerName = "example.com"
+
+				cfg, err := c.Build()
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(cfg.RootCAs).To(Not(BeNil()))
+				Expect(cfg.Certificates).To(HaveLen(1))
+				Expect(cfg.InsecureSkipVerify).To(BeTrue())
+				Expect(cfg.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+				Expect(cfg.ServerName).To(Equal("example.com"))
+			})
+
+			It("Builds a TLS config from files", func() {
+				// Write files
+				dir, err := os.MkdirTemp("", "goutils-tls")
+				Expect(err).To(Not(HaveOccurred()))
+				defer os.RemoveAll(dir)
+
+				files := map[string][]byte{"ca.pem": server.CAPEM, "cert.pem": server.ClientCertPEM, "key.pem": server.ClientKeyPEM}
+				for name, b := range files {
+					Expect(os.WriteFile(filepath.Join(dir, name), b, 0600)).To(Succeed())
+				}
+
+				// Call method
+				cfg, err := (&goutils.TLSConfig{
+					CAFile:   filepath.Join(dir, "ca.pem"),
+					CertFile: filepath.Join(dir, "cert.pem"),
+					KeyFile:  filepath.Join(dir, "key.pem"),
+				}).Build()
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(cfg.RootCAs).To(Not(BeNil()))
+				Expect(cfg.Certificates).To(HaveLen(1))
+			})
+
+			It("Uses the system's CAs and no client certificate by default", func() {
+				// Call method
+				cfg, err := goutils.NewTLSConfig().Build()
+
+				// Verify return values
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(cfg.RootCAs).To(BeNil())
+				Expect(cfg.Certificates).To(BeEmpty())
+			})
+		})
+
+		Context("The config is invalid", func() {
+			var (
+				// Input for `Build` input
+				input []*goutils.TLSConfig
+			)
+
+			BeforeEach(func() {
+				// Set input
+				input = []*goutils.TLSConfig{
+					{CAFile: "/does/not/exist.pem"},
+					{CAPEM: []byte("invalid")},
+					{CertFile: "/does/not/exist.pem"},
+					{CertFile: "/does/not/exist.pem", KeyFile: "/does/not/exist.pem"},
+					{CertPEM: server.ClientCertPEM},
+					{CertPEM: server.ClientCertPEM, KeyPEM: []byte("invalid")},
+				}
+			})
+
+			It("Returns an error", func() {
+				// Loop through test data
+				for _, input := range input {
+					// Call method
+					_, err := input.Build()
+
+					// Verify return value
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Describe("Making requests with TLS files", func() {
+		It("Picks up rotated files", func() {
+			// Create servers with different CAs and client certificates
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+			rotated, err := goutilstest.NewTLSTestServer(handler, true)
+			Expect(err).To(Not(HaveOccurred()))
+			defer rotated.Close()
+
+			original, err := goutilstest.NewTLSTestServer(handler, true)
+			Expect(err).To(Not(HaveOccurred()))
+			defer original.Close()
+
+			// Write files
+			dir, err := os.MkdirTemp("", "goutils-tls")
+			Expect(err).To(Not(HaveOccurred()))
+			defer os.RemoveAll(dir)
+
+			write := func(s *goutilstest.TLSTestServer, modTime time.Time) {
+				files := map[string][]byte{"ca.pem": s.CAPEM, "cert.pem": s.ClientCertPEM, "key.pem": s.ClientKeyPEM}
+				for name, b := range files {
+					path := filepath.Join(dir, name)
+					Expect(os.WriteFile(path, b, 0600)).To(Succeed())
+					Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+				}
+			}
+
+			c := &goutils.TLSConfig{
+				CAFile:   filepath.Join(dir, "ca.pem"),
+				CertFile: filepath.Join(dir, "cert.pem"),
+				KeyFile:  filepath.Join(dir, "key.pem"),
+			}
+
+			// Make a request with the original files
+			write(original, time.Now().Add(-time.Hour))
+			code, err := goutils.GetStatusCodeForRequest(&goutils.RequestConfig{Method: "GET", TLS: c, URL: original.URL})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+
+			// Rotate files, then verify requests use them
+			write(rotated, time.Now())
+			code, err = goutils.GetStatusCodeForRequest(&goutils.RequestConfig{Method: "GET", TLS: c, URL: rotated.URL})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(code).To(Equal(200))
+
+			// Verify cached clients can be dropped
+			goutils.DefaultClientFactory.Reset()
+		})
+	})
+})
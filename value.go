@@ -0,0 +1,402 @@
+// Package goutils contains a collection of useful Golang utility methods and libraries
+package goutils
+
+import (
+	// Standard lib
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"math"
+	"sort"
+	"strconv"
+)
+
+const (
+	// KindInvalid is the kind of a value that doesn't exist (ex: a missing key)
+	KindInvalid ValueKind = iota
+	// KindNull is the kind of a nil value
+	KindNull
+	// KindBool is the kind of a bool value
+	KindBool
+	// KindNumber is the kind of a numeric value
+	KindNumber
+	// KindString is the kind of a string value
+	KindString
+	// KindArray is the kind of a `[]interface{}` value
+	KindArray
+	// KindObject is the kind of a `map[string]interface{}` value
+	KindObject
+)
+
+type (
+	// Value wraps a decoded JSON-like value (as returned by `encoding/json` when decoding into an `interface{}`)
+	// and provides safe access to it's contents
+	// NOTE: Methods never panic, and never modify the value, so values can be shared across goroutines.
+	// The first error encountered while traversing a value is carried into the values returned by chained
+	// calls and can be retrieved with `Err`. Conversion errors are returned by the `As*` methods
+	Value struct {
+		err  error       // The first error encountered
+		path string      // The path used to reach the value, for error messages
+		v    interface{} // The underlying value
+	}
+
+	// ValueKind represents the kind of value a `Value` holds
+	ValueKind int
+)
+
+// NewValue returns a Value wrapping the value passed in
+func NewValue(i interface{}) *Value {
+	return &Value{v: i}
+}
+
+// ParseValue decodes JSON data and returns a Value wrapping the result
+// NOTE: Numbers are decoded as `json.Number` to avoid losing precision for large integers
+func ParseValue(data []byte) *Value {
+	var i interface{}
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	if err := d.Decode(&i); err != nil {
+		return &Value{err: err}
+	}
+
+	return NewValue(i)
+}
+
+// String returns the name of a value kind
+func (k ValueKind) String() string {
+	switch k {
+	case KindNull:
+		return "null"
+	case KindBool:
+		return "bool"
+	case KindNumber:
+		return "number"
+	case KindString:
+		return "string"
+	case KindArray:
+		return "array"
+	case KindObject:
+		return "object"
+	default:
+		return "invalid"
+	}
+}
+
+// AsBool returns the value as a bool, converting strings like "true" if needed,
+// or an error if the value doesn't exist or can't be converted
+func (v *Value) AsBool() (bool, error) {
+	if !v.Exists() {
+		return false, v.Err()
+	}
+
+	switch t := v.v.(type) {
+	case bool:
+		return t, nil
+	case string:
+		b, err := strconv.ParseBool(t)
+		if err != nil {
+			return false, fmt.Errorf("Value at '%s' can't be converted to bool: %s", v.location(), err.Error())
+		}
+
+		return b, nil
+	}
+
+	return false, v.kindErr("bool")
+}
+
+// AsFloat64 returns the value as a float64, converting numeric strings if needed,
+// or an error if the value doesn't exist or can't be converted
+func (v *Value) AsFloat64() (float64, error) {
+	if !v.Exists() {
+		return 0, v.Err()
+	}
+
+	var (
+		f   float64
+		err error
+	)
+
+	switch t := v.v.(type) {
+	case float64:
+		return t, nil
+	case int:
+		return float64(t), nil
+	case int64:
+		return float64(t), nil
+	case json.Number:
+		f, err = t.Float64()
+	case string:
+		f, err = strconv.ParseFloat(t, 64)
+	default:
+		return 0, v.kindErr("float64")
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("Value at '%s' can't be converted to float64: %s", v.location(), err.Error())
+	}
+
+	return f, nil
+}
+
+// AsInt64 returns the value as an int64, converting whole floats and numeric strings if needed,
+// or an error if the value doesn't exist or can't be converted without losing precision
+func (v *Value) AsInt64() (int64, error) {
+	if !v.Exists() {
+		return 0, v.Err()
+	}
+
+	var (
+		i   int64
+		err error
+	)
+
+	switch t := v.v.(type) {
+	case int:
+		return int64(t), nil
+	case int64:
+		return t, nil
+	case float64:
+		if t != math.Trunc(t) || t < math.MinInt64 || t >= math.MaxInt64 {
+			return 0, fmt.Errorf("Value at '%s' can't be converted to int64 without losing precision", v.location())
+		}
+
+		return int64(t), nil
+	case json.Number:
+		i, err = t.Int64()
+	case string:
+		i, err = strconv.ParseInt(t, 10, 64)
+	default:
+		return 0, v.kindErr("int64")
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("Value at '%s' can't be converted to int64: %s", v.location(), err.Error())
+	}
+
+	return i, nil
+}
+
+// AsString returns the value as a string, converting bools and numbers if needed,
+// or an error if the value doesn't exist or is an array or object
+// NOTE: Returns "" for null values
+func (v *Value) AsString() (string, error) {
+	if !v.Exists() {
+		return "", v.Err()
+	}
+
+	switch t := v.v.(type) {
+	case nil:
+		return "", nil
+	case bool:
+		return Bool2String(t), nil
+	case float64, int, int64, string:
+		return Interface2String(t), nil
+	case json.Number:
+		return t.String(), nil
+	}
+
+	return "", v.kindErr("string")
+}
+
+// Bool returns the value as a bool, converting strings like "true" if needed
+// NOTE: Returns false if the value can't be converted, see `AsBool` for the error
+func (v *Value) Bool() bool {
+	b, _ := v.AsBool()
+	return b
+}
+
+// Elements returns an iterator over the elements of an array value
+// NOTE: Yields nothing if the value isn't an array
+func (v *Value) Elements() iter.Seq2[int, *Value] {
+	return func(yield func(int, *Value) bool) {
+		if v.Kind() != KindArray {
+			return
+		}
+
+		for i := range v.v.([]interface{}) {
+			if !yield(i, v.Index(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Err returns the first error encountered while traversing to the value, if any
+func (v *Value) Err() error {
+	if v == nil {
+		return fmt.Errorf("Value is nil")
+	}
+
+	return v.err
+}
+
+// Exists returns true if the value was reached without any errors
+func (v *Value) Exists() bool {
+	return v.Err() == nil
+}
+
+// Fields returns an iterator over the keys and values of an object value, ordered by key
+// NOTE: Yields nothing if the value isn't an object
+func (v *Value) Fields() iter.Seq2[string, *Value] {
+	return func(yield func(string, *Value) bool) {
+		for _, k := range v.Keys() {
+			if !yield(k, v.Get(k)) {
+				return
+			}
+		}
+	}
+}
+
+// Float64 returns the value as a float64, converting numeric strings if needed
+// NOTE: Returns 0 if the value can't be converted, see `AsFloat64` for the error
+func (v *Value) Float64() float64 {
+	f, _ := v.AsFloat64()
+	return f
+}
+
+// Get returns the value of a key within an object value
+// NOTE: The returned value carries an error if the key doesn't exist or the value isn't an object
+func (v *Value) Get(key string) *Value {
+	path := key
+	if v != nil && v.path != "" {
+		path = v.path + "." + key
+	}
+
+	if !v.Exists() {
+		return &Value{err: v.Err(), path: path}
+	}
+
+	m, ok := v.v.(map[string]interface{})
+	if !ok {
+		return &Value{err: v.kindErr("object"), path: path}
+	}
+
+	child, ok := m[key]
+	if !ok {
+		return &Value{err: fmt.Errorf("Key '%s' not found", path), path: path}
+	}
+
+	return &Value{path: path, v: child}
+}
+
+// Index returns the value at an index within an array value
+// NOTE: The returned value carries an error if the index is out of range or the value isn't an array
+func (v *Value) Index(i int) *Value {
+	path := "[" + Int2String(i) + "]"
+	if v != nil {
+		path = v.path + path
+	}
+
+	if !v.Exists() {
+		return &Value{err: v.Err(), path: path}
+	}
+
+	s, ok := v.v.([]interface{})
+	if !ok {
+		return &Value{err: v.kindErr("array"), path: path}
+	}
+
+	if i < 0 || i >= len(s) {
+		return &Value{err: fmt.Errorf("Index '%s' out of range", path), path: path}
+	}
+
+	return &Value{path: path, v: s[i]}
+}
+
+// Int64 returns the value as an int64, converting whole floats and numeric strings if needed
+// NOTE: Returns 0 if the value can't be converted without losing precision, see `AsInt64` for the error
+func (v *Value) Int64() int64 {
+	i, _ := v.AsInt64()
+	return i
+}
+
+// Interface returns the underlying value, or nil if it doesn't exist
+func (v *Value) Interface() interface{} {
+	if !v.Exists() {
+		return nil
+	}
+
+	return v.v
+}
+
+// Keys returns the keys of an object value in sorted order, or nil if the value isn't an object
+func (v *Value) Keys() []string {
+	if v.Kind() != KindObject {
+		return nil
+	}
+
+	m := v.v.(map[string]interface{})
+
+	// Form return value
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Kind returns the kind of the underlying value
+// NOTE: Values that don't exist or are of unsupported types are `KindInvalid`
+func (v *Value) Kind() ValueKind {
+	if !v.Exists() {
+		return KindInvalid
+	}
+
+	switch v.v.(type) {
+	case nil:
+		return KindNull
+	case bool:
+		return KindBool
+	case float64, int, int64, json.Number:
+		return KindNumber
+	case string:
+		return KindString
+	case []interface{}:
+		return KindArray
+	case map[string]interface{}:
+		return KindObject
+	default:
+		return KindInvalid
+	}
+}
+
+// Len returns the length of an array, object or string value, or 0 for all other values
+func (v *Value) Len() int {
+	switch v.Kind() {
+	case KindArray:
+		return len(v.v.([]interface{}))
+	case KindObject:
+		return len(v.v.(map[string]interface{}))
+	case KindString:
+		return len(v.v.(string))
+	default:
+		return 0
+	}
+}
+
+// String returns the value as a string, converting bools and numbers if needed
+// NOTE: Returns "" for null values and values that can't be converted, see `AsString` for the error
+func (v *Value) String() string {
+	str, _ := v.AsString()
+	return str
+}
+
+// kindErr returns an error describing a value that isn't of the expected kind
+func (v *Value) kindErr(expected string) error {
+	return fmt.Errorf("Value at '%s' is of kind '%s', expected %s", v.location(), v.Kind(), expected)
+}
+
+// location returns the path used to reach the value, for error messages
+func (v *Value) location() string {
+	if v.path == "" {
+		return "$"
+	}
+
+	return v.path
+}
@@ -0,0 +1,194 @@
+// Tests the value.go file
+package goutils
+
+import (
+	// Third-party
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("value.go", func() {
+	var (
+		// Value to test against
+		v *Value
+	)
+
+	BeforeEach(func() {
+		// Set value
+		v = ParseValue([]byte(`{
+			"id": 9007199254740993,
+			"name": "foo",
+			"price": 12.5,
+			"active": true,
+			"flag": "false",
+			"missing": null,
+			"tags": ["bar", "baz"],
+			"nested": {"b": 2, "a": 1}
+		}`))
+	})
+
+	Describe("`ParseValue` method", func() {
+		Context("When the data is invalid JSON", func() {
+			It("Returns a value carrying an error", func() {
+				// Call method
+				actual := ParseValue([]byte(`{`))
+
+				// Verify return value
+				Expect(actual.Exists()).To(BeFalse())
+				Expect(actual.Err()).To(HaveOccurred())
+				Expect(actual.Get("foo").Err()).To(Equal(actual.Err()))
+			})
+		})
+
+		Context("When the data is valid JSON", func() {
+			It("Returns a value wrapping the decoded data", func() {
+				Expect(v.Exists()).To(BeTrue())
+				Expect(v.Kind()).To(Equal(KindObject))
+			})
+		})
+	})
+
+	Describe("`Get` and `Index` methods", func() {
+		It("Traverses objects and arrays", func() {
+			Expect(v.Get("tags").Index(1).String()).To(Equal("baz"))
+			Expect(v.Get("nested").Get("a").Int64()).To(Equal(int64(1)))
+		})
+
+		It("Carries the first error through chained calls", func() {
+			// Call methods
+			actual := v.Get("foo").Get("bar").Index(0)
+
+			// Verify return value
+			Expect(actual.Exists()).To(BeFalse())
+			Expect(actual.Err()).To(MatchError("Key 'foo' not found"))
+		})
+
+		It("Returns errors for out of range indexes and mismatched kinds", func() {
+			Expect(v.Get("tags").Index(2).Err()).To(MatchError("Index 'tags[2]' out of range"))
+			Expect(v.Get("name").Index(0).Err()).To(MatchError("Value at 'name' is of kind 'string', expected array"))
+			Expect(v.Get("tags").Get("foo").Err()).To(MatchError("Value at 'tags' is of kind 'array', expected object"))
+		})
+
+		It("Doesn't panic on nil values", func() {
+			// Create nil value
+			var n *Value
+
+			// Verify return values
+			Expect(n.Get("foo").Exists()).To(BeFalse())
+			Expect(n.Index(0).Exists()).To(BeFalse())
+			Expect(n.String()).To(Equal(""))
+			Expect(n.Kind()).To(Equal(KindInvalid))
+		})
+	})
+
+	Describe("Conversion methods", func() {
+		It("Converts values of compatible kinds", func() {
+			Expect(v.Get("id").Int64()).To(Equal(int64(9007199254740993)))
+			Expect(v.Get("id").String()).To(Equal("9007199254740993"))
+			Expect(v.Get("price").Float64()).To(Equal(12.5))
+			Expect(v.Get("active").Bool()).To(BeTrue())
+			Expect(v.Get("flag").Bool()).To(BeFalse())
+			Expect(v.Get("missing").String()).To(Equal(""))
+			Expect(NewValue(12.0).Int64()).To(Equal(int64(12)))
+			Expect(NewValue("12.5").Float64()).To(Equal(12.5))
+			Expect(NewValue(true).String()).To(Equal("true"))
+		})
+
+		It("Returns an error for values of incompatible kinds", func() {
+			// Loop through test data
+			for _, actual := range []*Value{
+				v.Get("tags"),
+				v.Get("name"),
+				v.Get("price"),
+				NewValue(12.5),
+				NewValue(map[string]interface{}{}),
+			} {
+				// Call conversion methods
+				_, strErr := actual.AsString()
+				_, intErr := actual.AsInt64()
+				_, floatErr := actual.AsFloat64()
+				_, boolErr := actual.AsBool()
+
+				// Verify at least one error was returned
+				Expect([]error{strErr, intErr, floatErr, boolErr}).To(ContainElement(HaveOccurred()))
+			}
+
+			// Verify specific errors
+			_, err := v.Get("tags").AsString()
+			Expect(err).To(MatchError("Value at 'tags' is of kind 'array', expected string"))
+
+			_, err = NewValue(12.5).AsInt64()
+			Expect(err).To(MatchError("Value at '$' can't be converted to int64 without losing precision"))
+
+			_, err = v.Get("nope").AsBool()
+			Expect(err).To(MatchError("Key 'nope' not found"))
+		})
+
+		It("Doesn't modify the value when a conversion fails", func() {
+			// Create value
+			obj := NewValue(map[string]interface{}{"a": 1.0})
+
+			// Call conversion methods
+			Expect(obj.String()).To(Equal(""))
+			Expect(obj.Int64()).To(BeZero())
+
+			// Verify the value can still be navigated
+			Expect(obj.Err()).To(Not(HaveOccurred()))
+			Expect(obj.Get("a").Int64()).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("`Kind` method", func() {
+		It("Returns the kind of the underlying value", func() {
+			Expect(v.Get("missing").Kind()).To(Equal(KindNull))
+			Expect(v.Get("active").Kind()).To(Equal(KindBool))
+			Expect(v.Get("price").Kind()).To(Equal(KindNumber))
+			Expect(v.Get("name").Kind()).To(Equal(KindString))
+			Expect(v.Get("tags").Kind()).To(Equal(KindArray))
+			Expect(v.Get("nope").Kind()).To(Equal(KindInvalid))
+			Expect(NewValue(struct{}{}).Kind()).To(Equal(KindInvalid))
+			Expect(KindObject.String()).To(Equal("object"))
+		})
+	})
+
+	Describe("Iteration methods", func() {
+		It("Iterates over array elements", func() {
+			// Collect elements
+			actual := []string{}
+			for i, e := range v.Get("tags").Elements() {
+				actual = append(actual, Int2String(i)+":"+e.String())
+			}
+
+			// Verify elements
+			Expect(actual).To(Equal([]string{"0:bar", "1:baz"}))
+			Expect(v.Get("tags").Len()).To(Equal(2))
+		})
+
+		It("Iterates over object fields in key order", func() {
+			// Collect fields
+			actual := []string{}
+			for k, f := range v.Get("nested").Fields() {
+				actual = append(actual, k+":"+f.String())
+			}
+
+			// Verify fields
+			Expect(actual).To(Equal([]string{"a:1", "b:2"}))
+			Expect(v.Get("nested").Keys()).To(Equal([]string{"a", "b"}))
+		})
+
+		It("Yields nothing for values of other kinds", func() {
+			// Collect elements and fields
+			count := 0
+			for range v.Get("name").Elements() {
+				count++
+			}
+			for range v.Get("name").Fields() {
+				count++
+			}
+
+			// Verify nothing was yielded
+			Expect(count).To(Equal(0))
+			Expect(v.Get("name").Keys()).To(BeNil())
+		})
+	})
+})